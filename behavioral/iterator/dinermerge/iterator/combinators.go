@@ -0,0 +1,213 @@
+package iterator
+
+import "slices"
+
+// lookahead es la base de los combinadores que necesitan saber si hay un
+// próximo elemento antes de entregarlo (Filter, Skip, Distinct).
+type lookahead[T any] struct {
+	advance func() (T, bool, error)
+	item    T
+	err     error
+	ready   bool
+	done    bool
+}
+
+func (l *lookahead[T]) HasNext() bool {
+	if l.ready {
+		return true
+	}
+	if l.done {
+		return false
+	}
+	item, ok, err := l.advance()
+	if !ok && err == nil {
+		l.done = true
+		return false
+	}
+	l.item, l.err, l.ready = item, err, true
+	return true
+}
+
+func (l *lookahead[T]) Next() (T, error) {
+	if !l.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	l.ready = false
+	return l.item, l.err
+}
+
+func Filter[T any](it Iterator[T], keep func(T) bool) Iterator[T] {
+	return &lookahead[T]{advance: func() (T, bool, error) {
+		for it.HasNext() {
+			item, err := it.Next()
+			if err != nil {
+				return item, true, err
+			}
+			if keep(item) {
+				return item, true, nil
+			}
+		}
+		var zero T
+		return zero, false, nil
+	}}
+}
+
+type mapIterator[T, U any] struct {
+	source    Iterator[T]
+	transform func(T) U
+}
+
+func Map[T, U any](it Iterator[T], transform func(T) U) Iterator[U] {
+	return &mapIterator[T, U]{source: it, transform: transform}
+}
+
+func (m *mapIterator[T, U]) HasNext() bool {
+	return m.source.HasNext()
+}
+
+func (m *mapIterator[T, U]) Next() (U, error) {
+	item, err := m.source.Next()
+	if err != nil {
+		var zero U
+		return zero, err
+	}
+	return m.transform(item), nil
+}
+
+type takeIterator[T any] struct {
+	source    Iterator[T]
+	remaining int
+}
+
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	return &takeIterator[T]{source: it, remaining: n}
+}
+
+func (t *takeIterator[T]) HasNext() bool {
+	return t.remaining > 0 && t.source.HasNext()
+}
+
+func (t *takeIterator[T]) Next() (T, error) {
+	if !t.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	t.remaining--
+	return t.source.Next()
+}
+
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	skipped := 0
+	return &lookahead[T]{advance: func() (T, bool, error) {
+		for skipped < n && it.HasNext() {
+			if item, err := it.Next(); err != nil {
+				return item, true, err
+			}
+			skipped++
+		}
+		if !it.HasNext() {
+			var zero T
+			return zero, false, nil
+		}
+		item, err := it.Next()
+		return item, true, err
+	}}
+}
+
+func Chain[T any](iterators ...Iterator[T]) Iterator[T] {
+	return NewCompositeIterator(iterators...)
+}
+
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+type zipIterator[A, B any] struct {
+	first  Iterator[A]
+	second Iterator[B]
+}
+
+// Zip combina elemento a elemento y termina con el iterador más corto.
+func Zip[A, B any](first Iterator[A], second Iterator[B]) Iterator[Pair[A, B]] {
+	return &zipIterator[A, B]{first: first, second: second}
+}
+
+func (z *zipIterator[A, B]) HasNext() bool {
+	return z.first.HasNext() && z.second.HasNext()
+}
+
+func (z *zipIterator[A, B]) Next() (Pair[A, B], error) {
+	if !z.HasNext() {
+		return Pair[A, B]{}, ErrNoMoreItems
+	}
+	a, err := z.first.Next()
+	if err != nil {
+		return Pair[A, B]{}, err
+	}
+	b, err := z.second.Next()
+	if err != nil {
+		return Pair[A, B]{}, err
+	}
+	return Pair[A, B]{First: a, Second: b}, nil
+}
+
+func Distinct[T comparable](it Iterator[T]) Iterator[T] {
+	return DistinctBy(it, func(item T) T { return item })
+}
+
+func DistinctBy[T any, K comparable](it Iterator[T], key func(T) K) Iterator[T] {
+	seen := make(map[K]struct{})
+	return Filter(it, func(item T) bool {
+		k := key(item)
+		if _, ok := seen[k]; ok {
+			return false
+		}
+		seen[k] = struct{}{}
+		return true
+	})
+}
+
+func Collect[T any](it Iterator[T]) ([]T, error) {
+	var items []T
+	for it.HasNext() {
+		item, err := it.Next()
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// Sorted consume el iterador de origen la primera vez que se lo recorre
+// y entrega los elementos ordenados de forma estable según compare.
+func Sorted[T any](it Iterator[T], compare func(a, b T) int) Iterator[T] {
+	var sorted Iterator[T]
+	var collectErr error
+	load := func() {
+		if sorted != nil {
+			return
+		}
+		items, err := Collect(it)
+		slices.SortStableFunc(items, compare)
+		sorted, collectErr = NewSliceIterator(items), err
+	}
+
+	return &lookahead[T]{advance: func() (T, bool, error) {
+		load()
+		if collectErr != nil {
+			err := collectErr
+			collectErr = nil
+			var zero T
+			return zero, true, err
+		}
+		if !sorted.HasNext() {
+			var zero T
+			return zero, false, nil
+		}
+		item, err := sorted.Next()
+		return item, true, err
+	}}
+}
@@ -0,0 +1,86 @@
+package iterator
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func ints(values ...int) Iterator[int] {
+	return NewSliceIterator(values)
+}
+
+func TestCombinators(t *testing.T) {
+	tests := []struct {
+		name string
+		it   Iterator[int]
+		want []int
+	}{
+		{"filter", Filter(ints(1, 2, 3, 4), func(n int) bool { return n%2 == 0 }), []int{2, 4}},
+		{"filter none", Filter(ints(1, 3), func(n int) bool { return n%2 == 0 }), nil},
+		{"map", Map(ints(1, 2), func(n int) int { return n * 10 }), []int{10, 20}},
+		{"take", Take(ints(1, 2, 3), 2), []int{1, 2}},
+		{"take more than available", Take(ints(1), 5), []int{1}},
+		{"skip", Skip(ints(1, 2, 3), 2), []int{3}},
+		{"skip everything", Skip(ints(1, 2), 5), nil},
+		{"chain", Chain(ints(1), ints(), ints(2, 3)), []int{1, 2, 3}},
+		{"distinct", Distinct(ints(3, 1, 3, 2, 1)), []int{3, 1, 2}},
+		{"sorted", Sorted(ints(3, 1, 2), cmp.Compare[int]), []int{1, 2, 3}},
+		{"composite", NewCompositeIterator(ints(1), ints(2)), []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Collect(tt.it)
+			if err != nil {
+				t.Fatalf("Collect: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			if _, err := tt.it.Next(); !errors.Is(err, ErrNoMoreItems) {
+				t.Fatalf("Next after the end = %v, want ErrNoMoreItems", err)
+			}
+		})
+	}
+}
+
+func TestZipStopsAtShortest(t *testing.T) {
+	zipped := Zip(ints(1, 2, 3), NewSliceIterator([]string{"a", "b"}))
+	got, err := Collect(zipped)
+	if err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+	want := []Pair[int, string]{{1, "a"}, {2, "b"}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if _, err := zipped.Next(); !errors.Is(err, ErrNoMoreItems) {
+		t.Fatalf("Next after the end = %v, want ErrNoMoreItems", err)
+	}
+}
+
+func TestDistinctByKey(t *testing.T) {
+	words := NewSliceIterator([]string{"Soup", "soup", "Salad"})
+	got, _ := Collect(DistinctBy[string](words, strings.ToLower))
+	if !slices.Equal(got, []string{"Soup", "Salad"}) {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestFailFastIterator(t *testing.T) {
+	var modCount uint64
+	it := NewFailFastIterator(ints(1, 2, 3), func() uint64 { return modCount })
+
+	if item, err := it.Next(); err != nil || item != 1 {
+		t.Fatalf("Next = %v, %v", item, err)
+	}
+	modCount++
+	if _, err := it.Next(); !errors.Is(err, ErrConcurrentModification) {
+		t.Fatalf("err = %v, want ErrConcurrentModification", err)
+	}
+	if it.HasNext() {
+		t.Fatal("HasNext should be false after a concurrent modification")
+	}
+}
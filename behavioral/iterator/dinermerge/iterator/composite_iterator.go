@@ -0,0 +1,30 @@
+package iterator
+
+// CompositeIterator recorre en orden una lista de iteradores. Como el
+// iterador de un menú compuesto incluye los iteradores de sus submenús,
+// el recorrido resultante es en profundidad.
+type CompositeIterator[T any] struct {
+	iterators []Iterator[T]
+}
+
+func NewCompositeIterator[T any](iterators ...Iterator[T]) *CompositeIterator[T] {
+	return &CompositeIterator[T]{iterators: iterators}
+}
+
+func (c *CompositeIterator[T]) HasNext() bool {
+	for len(c.iterators) > 0 {
+		if c.iterators[0].HasNext() {
+			return true
+		}
+		c.iterators = c.iterators[1:]
+	}
+	return false
+}
+
+func (c *CompositeIterator[T]) Next() (T, error) {
+	if !c.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	return c.iterators[0].Next()
+}
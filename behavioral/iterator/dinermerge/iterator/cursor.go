@@ -0,0 +1,112 @@
+package iterator
+
+import "errors"
+
+var (
+	ErrUnsupportedOperation = errors.New("operation not supported by this collection")
+	ErrNoCurrentItem        = errors.New("no current item: call Next or Previous first")
+	ErrNoPreviousItems      = errors.New("no previous items")
+)
+
+// Cursor amplía Iterator con navegación en ambos sentidos, como un
+// ListIterator. La posición queda entre dos elementos: Next devuelve el
+// de la derecha y Previous el de la izquierda.
+type Cursor[T any] interface {
+	Iterator[T]
+	HasPrevious() bool
+	Previous() (T, error)
+	// Peek devuelve el próximo elemento sin avanzar.
+	Peek() (T, error)
+	// Reset vuelve al principio.
+	Reset()
+	// Remove borra el último elemento devuelto por Next o Previous, también
+	// de la colección original si ésta lo permite.
+	Remove() error
+}
+
+// SliceCursor recorre una copia de los elementos. Si remove es nil, la
+// colección no admite borrar y Remove devuelve ErrUnsupportedOperation.
+type SliceCursor[T any] struct {
+	items    []T
+	position int
+	last     int
+	remove   func(T) error
+}
+
+func NewSliceCursor[T any](items []T, remove func(T) error) *SliceCursor[T] {
+	return &SliceCursor[T]{
+		items:  items,
+		last:   -1,
+		remove: remove,
+	}
+}
+
+// CursorFrom consume un iterador cualquiera y permite navegarlo, pero no
+// borrar, porque no conoce la colección de origen.
+func CursorFrom[T any](it Iterator[T]) (*SliceCursor[T], error) {
+	items, err := Collect(it)
+	if err != nil {
+		return nil, err
+	}
+	return NewSliceCursor(items, nil), nil
+}
+
+func (c *SliceCursor[T]) HasNext() bool {
+	return c.position < len(c.items)
+}
+
+func (c *SliceCursor[T]) Next() (T, error) {
+	if !c.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	c.last = c.position
+	c.position++
+	return c.items[c.last], nil
+}
+
+func (c *SliceCursor[T]) HasPrevious() bool {
+	return c.position > 0
+}
+
+func (c *SliceCursor[T]) Previous() (T, error) {
+	if !c.HasPrevious() {
+		var zero T
+		return zero, ErrNoPreviousItems
+	}
+	c.position--
+	c.last = c.position
+	return c.items[c.last], nil
+}
+
+func (c *SliceCursor[T]) Peek() (T, error) {
+	if !c.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	return c.items[c.position], nil
+}
+
+func (c *SliceCursor[T]) Reset() {
+	c.position = 0
+	c.last = -1
+}
+
+func (c *SliceCursor[T]) Remove() error {
+	if c.remove == nil {
+		return ErrUnsupportedOperation
+	}
+	if c.last < 0 {
+		return ErrNoCurrentItem
+	}
+	if err := c.remove(c.items[c.last]); err != nil {
+		return err
+	}
+
+	c.items = append(c.items[:c.last], c.items[c.last+1:]...)
+	if c.last < c.position {
+		c.position--
+	}
+	c.last = -1
+	return nil
+}
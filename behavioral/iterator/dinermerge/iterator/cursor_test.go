@@ -0,0 +1,72 @@
+package iterator
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSliceCursorNavigation(t *testing.T) {
+	c := NewSliceCursor([]string{"a", "b", "c"}, nil)
+
+	if _, err := c.Previous(); !errors.Is(err, ErrNoPreviousItems) {
+		t.Fatalf("Previous at start = %v, want ErrNoPreviousItems", err)
+	}
+	steps := []struct {
+		move func() (string, error)
+		want string
+	}{
+		{c.Next, "a"},
+		{c.Peek, "b"},
+		{c.Next, "b"},
+		{c.Previous, "b"},
+		{c.Previous, "a"},
+		{c.Next, "a"},
+	}
+	for i, step := range steps {
+		got, err := step.move()
+		if err != nil || got != step.want {
+			t.Fatalf("step %d: got %q, %v, want %q", i, got, err, step.want)
+		}
+	}
+
+	c.Reset()
+	got, _ := Collect[string](c)
+	if !slices.Equal(got, []string{"a", "b", "c"}) {
+		t.Fatalf("after Reset got %v", got)
+	}
+	if _, err := c.Peek(); !errors.Is(err, ErrNoMoreItems) {
+		t.Fatalf("Peek at end = %v, want ErrNoMoreItems", err)
+	}
+	if err := c.Remove(); !errors.Is(err, ErrUnsupportedOperation) {
+		t.Fatalf("Remove = %v, want ErrUnsupportedOperation", err)
+	}
+}
+
+func TestSliceCursorRemove(t *testing.T) {
+	var removed []string
+	c := NewSliceCursor([]string{"a", "b", "c"}, func(item string) error {
+		removed = append(removed, item)
+		return nil
+	})
+
+	if err := c.Remove(); !errors.Is(err, ErrNoCurrentItem) {
+		t.Fatalf("Remove before Next = %v, want ErrNoCurrentItem", err)
+	}
+	c.Next()
+	c.Next()
+	if err := c.Remove(); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if err := c.Remove(); !errors.Is(err, ErrNoCurrentItem) {
+		t.Fatalf("second Remove = %v, want ErrNoCurrentItem", err)
+	}
+	if next, _ := c.Next(); next != "c" {
+		t.Fatalf("Next after Remove = %q, want c", next)
+	}
+	c.Reset()
+	got, _ := Collect[string](c)
+	if !slices.Equal(got, []string{"a", "c"}) || !slices.Equal(removed, []string{"b"}) {
+		t.Fatalf("items %v, removed %v", got, removed)
+	}
+}
@@ -0,0 +1,44 @@
+package iterator
+
+import "errors"
+
+var ErrConcurrentModification = errors.New("collection was modified during iteration")
+
+// FailFastIterator recorre una copia, pero avisa si la colección original
+// cambió desde que se creó el iterador. Después de informar el error
+// HasNext devuelve false, para no trabar los bucles HasNext/Next.
+type FailFastIterator[T any] struct {
+	inner    Iterator[T]
+	modCount func() uint64
+	expected uint64
+	failed   bool
+}
+
+func NewFailFastIterator[T any](inner Iterator[T], modCount func() uint64) *FailFastIterator[T] {
+	return &FailFastIterator[T]{
+		inner:    inner,
+		modCount: modCount,
+		expected: modCount(),
+	}
+}
+
+func (f *FailFastIterator[T]) HasNext() bool {
+	return !f.failed && f.inner.HasNext()
+}
+
+func (f *FailFastIterator[T]) Next() (T, error) {
+	if f.failed {
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	if f.modified() {
+		f.failed = true
+		var zero T
+		return zero, ErrConcurrentModification
+	}
+	return f.inner.Next()
+}
+
+func (f *FailFastIterator[T]) modified() bool {
+	return f.modCount() != f.expected
+}
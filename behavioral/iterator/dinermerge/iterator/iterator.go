@@ -1,5 +1,10 @@
 package iterator
 
+import "errors"
+
+// ErrNoMoreItems lo devuelve Next cuando el recorrido terminó.
+var ErrNoMoreItems = errors.New("no more items")
+
 type Iterator[T any] interface {
 	HasNext() bool
 	Next() (T, error)
@@ -2,30 +2,14 @@ package iterator
 
 import (
 	"designpatterns/behavioral/iterator/dinermerge/models"
-	"errors"
 )
 
 type PancakeHouseMenuIterator struct {
-	menuItems []*models.MenuItem
-	position  int
+	*SliceIterator[*models.MenuItem]
 }
 
 func NewPancakeHouseMenuIterator(items []*models.MenuItem) *PancakeHouseMenuIterator {
 	return &PancakeHouseMenuIterator{
-		menuItems: items,
-		position:  0,
+		SliceIterator: NewSliceIterator(items),
 	}
 }
-
-func (p *PancakeHouseMenuIterator) HasNext() bool {
-	return p.position < len(p.menuItems)
-}
-
-func (p *PancakeHouseMenuIterator) Next() (*models.MenuItem, error) {
-	if !p.HasNext() {
-		return nil, errors.New("no more items")
-	}
-	menuItem := p.menuItems[p.position]
-	p.position++
-	return menuItem, nil
-}
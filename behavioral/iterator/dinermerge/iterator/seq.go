@@ -0,0 +1,72 @@
+package iterator
+
+import "iter"
+
+// ToSeq adapta un Iterator al protocolo range-over-func. El recorrido se
+// detiene en el primer error de Next.
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.HasNext() {
+			item, err := it.Next()
+			if err != nil || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// SeqOf crea un iterador nuevo en cada recorrido, así la secuencia se
+// puede recorrer varias veces y cada vez parte del estado actual.
+func SeqOf[T any](create func() Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ToSeq(create())(yield)
+	}
+}
+
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for item := range seq {
+			if !yield(i, item) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// SeqIterator adapta un iter.Seq a la interfaz Iterator. Si no se recorre
+// hasta el final hay que llamar a Stop para liberar la secuencia.
+type SeqIterator[T any] struct {
+	next    func() (T, bool)
+	stop    func()
+	peeked  bool
+	item    T
+	hasItem bool
+}
+
+func FromSeq[T any](seq iter.Seq[T]) *SeqIterator[T] {
+	next, stop := iter.Pull(seq)
+	return &SeqIterator[T]{next: next, stop: stop}
+}
+
+func (s *SeqIterator[T]) HasNext() bool {
+	if !s.peeked {
+		s.item, s.hasItem = s.next()
+		s.peeked = true
+	}
+	return s.hasItem
+}
+
+func (s *SeqIterator[T]) Next() (T, error) {
+	if !s.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	s.peeked = false
+	return s.item, nil
+}
+
+func (s *SeqIterator[T]) Stop() {
+	s.stop()
+}
@@ -0,0 +1,52 @@
+package iterator
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSeqOfCanBeRangedTwice(t *testing.T) {
+	items := []int{1, 2, 3}
+	seq := SeqOf(func() Iterator[int] { return NewSliceIterator(items) })
+
+	first := slices.Collect(seq)
+	second := slices.Collect(seq)
+	if !slices.Equal(first, items) || !slices.Equal(second, items) {
+		t.Fatalf("first %v, second %v, want %v both times", first, second, items)
+	}
+}
+
+func TestToSeqIsSingleUse(t *testing.T) {
+	seq := ToSeq[int](NewSliceIterator([]int{1, 2}))
+	if first := slices.Collect(seq); len(first) != 2 {
+		t.Fatalf("got %v, want two items", first)
+	}
+	if rest := slices.Collect(seq); len(rest) != 0 {
+		t.Fatalf("got %v, ToSeq wraps a single iterator", rest)
+	}
+}
+
+func TestEnumerateStopsEarly(t *testing.T) {
+	seq := SeqOf(func() Iterator[string] { return NewSliceIterator([]string{"a", "b", "c"}) })
+	var got []int
+	for i, item := range Enumerate(seq) {
+		if item == "c" {
+			break
+		}
+		got = append(got, i)
+	}
+	if !slices.Equal(got, []int{0, 1}) {
+		t.Fatalf("got %v, want [0 1]", got)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	it := FromSeq(slices.Values([]int{4, 5}))
+	defer it.Stop()
+	if got, err := Collect[int](it); err != nil || !slices.Equal(got, []int{4, 5}) {
+		t.Fatalf("got %v, %v, want [4 5]", got, err)
+	}
+	if _, err := it.Next(); err == nil {
+		t.Fatal("Next after the end should fail")
+	}
+}
@@ -0,0 +1,27 @@
+package iterator
+
+type SliceIterator[T any] struct {
+	items    []T
+	position int
+}
+
+func NewSliceIterator[T any](items []T) *SliceIterator[T] {
+	return &SliceIterator[T]{
+		items:    items,
+		position: 0,
+	}
+}
+
+func (s *SliceIterator[T]) HasNext() bool {
+	return s.position < len(s.items)
+}
+
+func (s *SliceIterator[T]) Next() (T, error) {
+	if !s.HasNext() {
+		var zero T
+		return zero, ErrNoMoreItems
+	}
+	item := s.items[s.position]
+	s.position++
+	return item, nil
+}
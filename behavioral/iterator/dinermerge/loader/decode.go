@@ -0,0 +1,233 @@
+package loader
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+var (
+	knownTags = []models.DietaryTag{
+		models.TagVegetarian, models.TagVegan, models.TagGlutenFree, models.TagNutFree, models.TagSpicy,
+	}
+	knownAllergens = []models.Allergen{
+		models.AllergenGluten, models.AllergenDairy, models.AllergenEggs, models.AllergenNuts,
+		models.AllergenPeanuts, models.AllergenSoy, models.AllergenFish, models.AllergenShellfish,
+	}
+)
+
+// decoder convierte el árbol en MenuSpec acumulando todos los errores,
+// para que quien edita el archivo vea todos los problemas de una vez.
+type decoder struct {
+	errs ValidationErrors
+}
+
+func (d *decoder) fail(n *node, path string, format string, args ...any) {
+	d.errs = append(d.errs, &ValidationError{Line: n.line, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *decoder) menu(n *node, path string, currency money.Currency) MenuSpec {
+	var spec MenuSpec
+	if !d.expect(n, mappingNode, path) {
+		return spec
+	}
+
+	for _, key := range currencyFirst(n.keys) {
+		value := n.fields[key]
+		switch key {
+		case "currency":
+			spec.Currency = d.string(value, join(path, key))
+			parsed, err := money.CurrencyByCode(spec.Currency)
+			if err != nil {
+				d.fail(value, join(path, key), "%v", err)
+			} else {
+				currency = parsed
+			}
+		case "available":
+			spec.Available = d.windows(value, join(path, key))
+		case "name":
+			spec.Name = d.string(value, join(path, key))
+		case "description":
+			spec.Description = d.string(value, join(path, key))
+		case "items":
+			for i, item := range d.sequence(value, join(path, key)) {
+				spec.Items = append(spec.Items, d.item(item, index(join(path, key), i), currency))
+			}
+		case "sections":
+			for i, section := range d.sequence(value, join(path, key)) {
+				spec.Sections = append(spec.Sections, d.menu(section, index(join(path, key), i), currency))
+			}
+		default:
+			d.fail(value, join(path, key), "unknown field")
+		}
+	}
+
+	if spec.Name == "" {
+		d.fail(n, join(path, "name"), "is required")
+	}
+	seen := make(map[string]bool)
+	for i, item := range spec.Items {
+		if item.Name != "" && seen[item.Name] {
+			d.fail(n.fields["items"].items[i], index(join(path, "items"), i), "duplicate item %q", item.Name)
+		}
+		seen[item.Name] = true
+	}
+	return spec
+}
+
+func (d *decoder) item(n *node, path string, currency money.Currency) ItemSpec {
+	var spec ItemSpec
+	if !d.expect(n, mappingNode, path) {
+		return spec
+	}
+
+	for _, key := range n.keys {
+		value := n.fields[key]
+		switch key {
+		case "name":
+			spec.Name = d.string(value, join(path, key))
+		case "description":
+			spec.Description = d.string(value, join(path, key))
+		case "price":
+			spec.Price = d.price(value, join(path, key), currency)
+		case "vegetarian":
+			spec.Vegetarian = d.bool(value, join(path, key))
+		case "available":
+			spec.Available = d.windows(value, join(path, key))
+		case "tags":
+			for i, tag := range d.sequence(value, join(path, key)) {
+				name := d.string(tag, index(join(path, key), i))
+				if name != "" && !slices.Contains(knownTags, models.DietaryTag(name)) {
+					d.fail(tag, index(join(path, key), i), "unknown dietary tag %q", name)
+				}
+				spec.Tags = append(spec.Tags, name)
+			}
+		case "allergens":
+			for i, allergen := range d.sequence(value, join(path, key)) {
+				name := d.string(allergen, index(join(path, key), i))
+				if name != "" && !slices.Contains(knownAllergens, models.Allergen(name)) {
+					d.fail(allergen, index(join(path, key), i), "unknown allergen %q", name)
+				}
+				spec.Allergens = append(spec.Allergens, name)
+			}
+		default:
+			d.fail(value, join(path, key), "unknown field")
+		}
+	}
+
+	if spec.Name == "" {
+		d.fail(n, join(path, "name"), "is required")
+	}
+	if _, ok := n.fields["price"]; !ok {
+		d.fail(n, join(path, "price"), "is required")
+	}
+	return spec
+}
+
+// windows acepta un horario suelto o una lista de horarios.
+func (d *decoder) windows(n *node, path string) []string {
+	items := []*node{n}
+	if n.kind == sequenceNode {
+		items = n.items
+	}
+
+	var windows []string
+	for i, item := range items {
+		itemPath := path
+		if n.kind == sequenceNode {
+			itemPath = index(path, i)
+		}
+		text := d.string(item, itemPath)
+		if _, err := models.ParseWindow(text); err != nil {
+			d.fail(item, itemPath, "%v", err)
+			continue
+		}
+		windows = append(windows, text)
+	}
+	return windows
+}
+
+func (d *decoder) expect(n *node, kind nodeKind, path string) bool {
+	if n.kind == kind {
+		return true
+	}
+	names := map[nodeKind]string{scalarNode: "a value", mappingNode: "an object", sequenceNode: "a list"}
+	d.fail(n, path, "expected %s", names[kind])
+	return false
+}
+
+func (d *decoder) sequence(n *node, path string) []*node {
+	if n.kind == scalarNode && n.scalar == nullScalar {
+		return nil
+	}
+	if !d.expect(n, sequenceNode, path) {
+		return nil
+	}
+	return n.items
+}
+
+func (d *decoder) string(n *node, path string) string {
+	if !d.expect(n, scalarNode, path) {
+		return ""
+	}
+	if n.scalar == nullScalar {
+		return ""
+	}
+	return n.value
+}
+
+func (d *decoder) bool(n *node, path string) bool {
+	if !d.expect(n, scalarNode, path) {
+		return false
+	}
+	if n.scalar != boolScalar {
+		d.fail(n, path, "expected true or false, got %q", n.value)
+		return false
+	}
+	return n.value == "true"
+}
+
+// price valida el importe en texto, sin pasar por float64, para no
+// aceptar más decimales de los que admite la moneda.
+func (d *decoder) price(n *node, path string, currency money.Currency) json.Number {
+	if !d.expect(n, scalarNode, path) {
+		return ""
+	}
+	if n.scalar != numberScalar {
+		d.fail(n, path, "expected a number, got %q", n.value)
+		return ""
+	}
+	value, err := money.Parse(n.value, currency)
+	if err != nil {
+		d.fail(n, path, "%v", err)
+		return ""
+	}
+	if value.IsNegative() {
+		d.fail(n, path, "must not be negative")
+	}
+	return json.Number(n.value)
+}
+
+// currencyFirst adelanta la moneda porque los precios dependen de ella,
+// aunque en el archivo aparezca después de los ítems.
+func currencyFirst(keys []string) []string {
+	i := slices.Index(keys, "currency")
+	if i <= 0 {
+		return keys
+	}
+	ordered := append([]string{"currency"}, keys[:i]...)
+	return append(ordered, keys[i+1:]...)
+}
+
+func join(path string, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
@@ -0,0 +1,192 @@
+package loader
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// SpecFromMenu arma la forma exportable de cualquier menú. Los menús sin
+// nombre que cuelgan de un compuesto aportan sus ítems a la sección padre.
+func SpecFromMenu(m menu.Menu) MenuSpec {
+	return specFromMenu(m, money.CurrencyUSD)
+}
+
+// specFromMenu solo escribe la moneda cuando cambia respecto de la
+// sección padre, igual que la hereda el loader.
+func specFromMenu(m menu.Menu, parent money.Currency) MenuSpec {
+	var spec MenuSpec
+	for item := range m.All() {
+		if currency := item.Price.Currency(); currency != parent {
+			spec.Currency = currency.Code
+			parent = currency
+		}
+		break
+	}
+	if named, ok := m.(menu.NamedMenu); ok {
+		spec.Name = named.GetName()
+		spec.Description = named.GetDescription()
+	}
+
+	composite, ok := m.(*menu.CompositeMenu)
+	if ok {
+		spec.Available = windowStrings(composite.Availability())
+	}
+	if !ok {
+		for item := range m.All() {
+			spec.Items = append(spec.Items, specFromItem(item))
+		}
+		return spec
+	}
+
+	for _, entry := range composite.Entries() {
+		if entry.SubMenu == nil {
+			spec.Items = append(spec.Items, specFromItem(entry.Item))
+			continue
+		}
+		if _, named := entry.SubMenu.(menu.NamedMenu); named {
+			spec.Sections = append(spec.Sections, specFromMenu(entry.SubMenu, parent))
+			continue
+		}
+		spec.Items = append(spec.Items, specFromMenu(entry.SubMenu, parent).Items...)
+	}
+	return spec
+}
+
+func specFromItem(item *models.MenuItem) ItemSpec {
+	spec := ItemSpec{
+		Name:        item.Name,
+		Description: item.Description,
+		Price:       json.Number(item.Price.Decimal()),
+		Vegetarian:  item.Vegetarian,
+	}
+	for _, tag := range item.Tags {
+		spec.Tags = append(spec.Tags, string(tag))
+	}
+	for _, allergen := range item.Allergens {
+		spec.Allergens = append(spec.Allergens, string(allergen))
+	}
+	spec.Available = windowStrings(item.Available)
+	return spec
+}
+
+func windowStrings(availability models.Availability) []string {
+	var windows []string
+	for _, window := range availability {
+		windows = append(windows, window.String())
+	}
+	return windows
+}
+
+func Export(w io.Writer, m menu.Menu, format Format) error {
+	spec := SpecFromMenu(m)
+	switch format {
+	case JSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(spec)
+	case YAMLSubset:
+		var b strings.Builder
+		writeYAMLMenu(&b, spec, "")
+		_, err := io.WriteString(w, b.String())
+		return err
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func ExportFile(path string, m menu.Menu) error {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := Export(file, m, format); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func writeYAMLMenu(b *strings.Builder, spec MenuSpec, indent string) {
+	fmt.Fprintf(b, "%sname: %s\n", indent, yamlString(spec.Name))
+	if spec.Currency != "" {
+		fmt.Fprintf(b, "%scurrency: %s\n", indent, spec.Currency)
+	}
+	if spec.Description != "" {
+		fmt.Fprintf(b, "%sdescription: %s\n", indent, yamlString(spec.Description))
+	}
+	writeYAMLWindows(b, spec.Available, indent)
+	if len(spec.Items) > 0 {
+		fmt.Fprintf(b, "%sitems:\n", indent)
+		for _, item := range spec.Items {
+			fmt.Fprintf(b, "%s  - name: %s\n", indent, yamlString(item.Name))
+			if item.Description != "" {
+				fmt.Fprintf(b, "%s    description: %s\n", indent, yamlString(item.Description))
+			}
+			fmt.Fprintf(b, "%s    price: %s\n", indent, item.Price)
+			if item.Vegetarian {
+				fmt.Fprintf(b, "%s    vegetarian: true\n", indent)
+			}
+			if len(item.Tags) > 0 {
+				fmt.Fprintf(b, "%s    tags: [%s]\n", indent, strings.Join(item.Tags, ", "))
+			}
+			if len(item.Allergens) > 0 {
+				fmt.Fprintf(b, "%s    allergens: [%s]\n", indent, strings.Join(item.Allergens, ", "))
+			}
+			writeYAMLWindows(b, item.Available, indent+"    ")
+		}
+	}
+	if len(spec.Sections) > 0 {
+		fmt.Fprintf(b, "%ssections:\n", indent)
+		for _, section := range spec.Sections {
+			var nested strings.Builder
+			writeYAMLMenu(&nested, section, "")
+			lines := strings.Split(strings.TrimRight(nested.String(), "\n"), "\n")
+			for i, line := range lines {
+				prefix := indent + "    "
+				if i == 0 {
+					prefix = indent + "  - "
+				}
+				fmt.Fprintf(b, "%s%s\n", prefix, line)
+			}
+		}
+	}
+}
+
+func writeYAMLWindows(b *strings.Builder, windows []string, indent string) {
+	if len(windows) == 0 {
+		return
+	}
+	quoted := make([]string, len(windows))
+	for i, window := range windows {
+		quoted[i] = strconv.Quote(window)
+	}
+	fmt.Fprintf(b, "%savailable: [%s]\n", indent, strings.Join(quoted, ", "))
+}
+
+// yamlString usa comillas solo cuando el valor podría leerse como otro
+// tipo o romper la sintaxis.
+func yamlString(value string) string {
+	if value == "" || strings.ContainsAny(value, ":#[]{},\"'&*!|>%@`") ||
+		strings.TrimSpace(value) != value || strings.HasPrefix(value, "-") {
+		return strconv.Quote(value)
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return strconv.Quote(value)
+	}
+	switch strings.ToLower(value) {
+	case "true", "false", "null", "~":
+		return strconv.Quote(value)
+	}
+	return value
+}
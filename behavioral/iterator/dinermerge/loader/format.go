@@ -0,0 +1,28 @@
+package loader
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	JSON       Format = "json"
+	YAMLSubset Format = "yaml-subset"
+)
+
+// FormatFromPath solo reconoce .json. Los .yaml y .yml no se asocian a
+// YAMLSubset porque un YAML válido puede usar sintaxis que el subconjunto
+// rechaza; para leerlos hay que elegir el formato con LoadFileAs.
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return JSON, nil
+	case ".yaml", ".yml":
+		return "", fmt.Errorf("%s: only a YAML subset is supported, choose YAMLSubset explicitly", path)
+	default:
+		return "", fmt.Errorf("unknown menu file format: %s", path)
+	}
+}
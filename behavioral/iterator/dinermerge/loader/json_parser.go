@@ -0,0 +1,114 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type jsonParser struct {
+	data    []byte
+	decoder *json.Decoder
+}
+
+func parseJSON(data []byte) (*node, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	p := &jsonParser{data: data, decoder: decoder}
+
+	root, err := p.parseValue()
+	if err != nil {
+		return nil, p.wrap(err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, &ValidationError{Line: p.line(), Message: "unexpected data after the top-level value"}
+	}
+	return root, nil
+}
+
+func (p *jsonParser) parseValue() (*node, error) {
+	token, err := p.decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	line := p.line()
+
+	switch t := token.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			return p.parseObject(line)
+		case '[':
+			return p.parseArray(line)
+		}
+		return nil, fmt.Errorf("unexpected %q", t)
+	case string:
+		return &node{kind: scalarNode, line: line, value: t, scalar: stringScalar}, nil
+	case json.Number:
+		return &node{kind: scalarNode, line: line, value: t.String(), scalar: numberScalar}, nil
+	case bool:
+		return &node{kind: scalarNode, line: line, value: fmt.Sprint(t), scalar: boolScalar}, nil
+	default:
+		return &node{kind: scalarNode, line: line, scalar: nullScalar}, nil
+	}
+}
+
+func (p *jsonParser) parseObject(line int) (*node, error) {
+	object := newMapping(line)
+	for p.decoder.More() {
+		token, err := p.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := token.(string)
+		keyLine := p.line()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if err := object.set(key, value, keyLine); err != nil {
+			return nil, err
+		}
+	}
+	_, err := p.decoder.Token()
+	return object, err
+}
+
+func (p *jsonParser) parseArray(line int) (*node, error) {
+	array := &node{kind: sequenceNode, line: line}
+	for p.decoder.More() {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		array.items = append(array.items, value)
+	}
+	_, err := p.decoder.Token()
+	return array, err
+}
+
+func (p *jsonParser) line() int {
+	return lineAt(p.data, p.decoder.InputOffset())
+}
+
+func (p *jsonParser) wrap(err error) error {
+	var validationErr *ValidationError
+	if errors.As(err, &validationErr) {
+		return err
+	}
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return &ValidationError{Line: lineAt(p.data, syntaxErr.Offset), Message: syntaxErr.Error()}
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return &ValidationError{Line: lineAt(p.data, int64(len(p.data))), Message: "unexpected end of JSON input"}
+	}
+	return &ValidationError{Line: p.line(), Message: err.Error()}
+}
+
+func lineAt(data []byte, offset int64) int {
+	offset = min(offset, int64(len(data)))
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,136 @@
+package loader
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"fmt"
+	"io"
+	"os"
+)
+
+func Parse(data []byte, format Format) (MenuSpec, error) {
+	var root *node
+	var err error
+	switch format {
+	case JSON:
+		root, err = parseJSON(data)
+	case YAMLSubset:
+		root, err = parseYAMLSubset(data)
+	default:
+		return MenuSpec{}, fmt.Errorf("unsupported format: %s", format)
+	}
+	if err != nil {
+		return MenuSpec{}, err
+	}
+
+	d := &decoder{}
+	spec := d.menu(root, "", money.CurrencyUSD)
+	if len(d.errs) > 0 {
+		return MenuSpec{}, d.errs
+	}
+	return spec, nil
+}
+
+func Build(spec MenuSpec) (*menu.CompositeMenu, error) {
+	return build(spec, money.CurrencyUSD)
+}
+
+func build(spec MenuSpec, currency money.Currency) (*menu.CompositeMenu, error) {
+	if spec.Currency != "" {
+		parsed, err := money.CurrencyByCode(spec.Currency)
+		if err != nil {
+			return nil, err
+		}
+		currency = parsed
+	}
+
+	composite := menu.NewCompositeMenu(spec.Name, spec.Description)
+	available, err := parseWindows(spec.Available)
+	if err != nil {
+		return nil, fmt.Errorf("menu %q: %w", spec.Name, err)
+	}
+	composite.WithAvailability(available...)
+	for _, item := range spec.Items {
+		built, err := buildItem(item, currency)
+		if err != nil {
+			return nil, err
+		}
+		composite.AddItem(built)
+	}
+	for _, section := range spec.Sections {
+		submenu, err := build(section, currency)
+		if err != nil {
+			return nil, err
+		}
+		if err := composite.AddSubMenu(submenu); err != nil {
+			return nil, err
+		}
+	}
+	return composite, nil
+}
+
+func buildItem(spec ItemSpec, currency money.Currency) (*models.MenuItem, error) {
+	price, err := money.Parse(spec.Price.String(), currency)
+	if err != nil {
+		return nil, fmt.Errorf("item %q: %w", spec.Name, err)
+	}
+	item := models.NewMenuItem(spec.Name, spec.Description, spec.Vegetarian, price)
+	for _, tag := range spec.Tags {
+		item.WithTags(models.DietaryTag(tag))
+	}
+	for _, allergen := range spec.Allergens {
+		item.WithAllergens(models.Allergen(allergen))
+	}
+	available, err := parseWindows(spec.Available)
+	if err != nil {
+		return nil, fmt.Errorf("item %q: %w", spec.Name, err)
+	}
+	return item.WithAvailability(available...), nil
+}
+
+func parseWindows(texts []string) ([]models.Window, error) {
+	var windows []models.Window
+	for _, text := range texts {
+		window, err := models.ParseWindow(text)
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, window)
+	}
+	return windows, nil
+}
+
+func Load(r io.Reader, format Format) (*menu.CompositeMenu, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := Parse(data, format)
+	if err != nil {
+		return nil, err
+	}
+	return Build(spec)
+}
+
+func LoadFile(path string) (*menu.CompositeMenu, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return LoadFileAs(path, format)
+}
+
+func LoadFileAs(path string, format Format) (*menu.CompositeMenu, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	m, err := Load(file, format)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
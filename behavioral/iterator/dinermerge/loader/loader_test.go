@@ -0,0 +1,169 @@
+package loader
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const specialsJSON = `{
+  "name": "SPECIALS",
+  "currency": "JPY",
+  "items": [
+    {"name": "Ramen", "price": 900, "tags": ["spicy"]}
+  ],
+  "sections": [
+    {"name": "DRINKS", "items": [{"name": "Tea", "description": "*Chef's* pick: 100% leaves", "price": 300, "vegetarian": true}]}
+  ]
+}`
+
+const specialsYAML = `name: SPECIALS
+items:
+  - name: Ramen
+    price: 900
+    tags: [spicy]
+sections:
+  - name: DRINKS
+    items:
+      - name: Tea
+        description: "*Chef's* pick: 100% leaves"
+        price: 300
+        vegetarian: true
+currency: JPY
+`
+
+func TestParseFormatsAgree(t *testing.T) {
+	fromJSON, err := Parse([]byte(specialsJSON), JSON)
+	if err != nil {
+		t.Fatalf("JSON: %v", err)
+	}
+	fromYAML, err := Parse([]byte(specialsYAML), YAMLSubset)
+	if err != nil {
+		t.Fatalf("YAML subset: %v", err)
+	}
+	if !reflect.DeepEqual(fromJSON, fromYAML) {
+		t.Fatalf("JSON %+v\nYAML %+v", fromJSON, fromYAML)
+	}
+}
+
+func TestCurrencyAppliesToItemsListedBeforeIt(t *testing.T) {
+	// JPY no tiene decimales: si la moneda se leyera después de los
+	// ítems, 1.5 se validaría como USD y pasaría.
+	data := "name: A\nitems:\n  - name: Tea\n    price: 1.5\ncurrency: JPY\n"
+	_, err := Parse([]byte(data), YAMLSubset)
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "items[0].price" {
+		t.Fatalf("err = %v, want one error on items[0].price", err)
+	}
+}
+
+func TestParseReportsAllValidationErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		data   string
+		want   []string
+	}{
+		{
+			name:   "yaml subset",
+			format: YAMLSubset,
+			data:   "name: SPECIALS\nitems:\n  - name: Waffles\n    price: -2\n    tags: [keto]\n  - description: no name\n",
+			want: []string{
+				"line 4: items[0].price: must not be negative",
+				`line 5: items[0].tags[0]: unknown dietary tag "keto"`,
+				"line 6: items[1].name: is required",
+				"line 6: items[1].price: is required",
+			},
+		},
+		{
+			name:   "json",
+			format: JSON,
+			data:   "{\n  \"name\": \"A\",\n  \"colour\": \"red\",\n  \"items\": [{\"name\": \"Tea\", \"price\": \"cheap\"}]\n}",
+			want: []string{
+				"line 3: colour: unknown field",
+				`line 4: items[0].price: expected a number, got "cheap"`,
+			},
+		},
+		{
+			name:   "duplicate items",
+			format: YAMLSubset,
+			data:   "name: A\nitems:\n  - name: Tea\n    price: 1\n  - name: Tea\n    price: 2\n",
+			want:   []string{`line 5: items[1]: duplicate item "Tea"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.data), tt.format)
+			var errs ValidationErrors
+			if !errors.As(err, &errs) {
+				t.Fatalf("err = %v, want ValidationErrors", err)
+			}
+			var got []string
+			for _, e := range errs {
+				got = append(got, e.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestParseJSONRejectsDuplicateKeys(t *testing.T) {
+	data := "{\n  \"name\": \"A\",\n  \"items\": [{\"name\": \"Tea\", \"price\": 1,\n    \"price\": 2}]\n}"
+	_, err := Parse([]byte(data), JSON)
+	var ve *ValidationError
+	if !errors.As(err, &ve) || ve.Line != 4 || ve.Path != "price" || ve.Message != "duplicate key" {
+		t.Fatalf("err = %v, want a duplicate key error on line 4", err)
+	}
+}
+
+func TestExportRoundTrip(t *testing.T) {
+	for _, format := range []Format{JSON, YAMLSubset} {
+		t.Run(string(format), func(t *testing.T) {
+			original, err := Load(strings.NewReader(specialsJSON), JSON)
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			var buf bytes.Buffer
+			if err := Export(&buf, original, format); err != nil {
+				t.Fatalf("Export: %v", err)
+			}
+			reloaded, err := Load(&buf, format)
+			if err != nil {
+				t.Fatalf("reload: %v\n%s", err, buf.String())
+			}
+			if !reflect.DeepEqual(SpecFromMenu(original), SpecFromMenu(reloaded)) {
+				t.Fatalf("round trip changed the menu:\n%+v\n%+v", SpecFromMenu(original), SpecFromMenu(reloaded))
+			}
+		})
+	}
+}
+
+func TestLoadFileCafeMenu(t *testing.T) {
+	m, err := LoadFileAs("../data/cafe_menu.yaml", YAMLSubset)
+	if err != nil {
+		t.Fatalf("LoadFileAs: %v", err)
+	}
+	count := 0
+	for range m.All() {
+		count++
+	}
+	if count != 4 {
+		t.Fatalf("cafe menu has %d items, want 4", count)
+	}
+}
+
+func TestFormatFromPath(t *testing.T) {
+	if got, err := FormatFromPath("menu.JSON"); err != nil || got != JSON {
+		t.Errorf("FormatFromPath(menu.JSON) = %q, %v", got, err)
+	}
+	// YAMLSubset no es YAML completo: hay que elegirlo explícitamente
+	for _, path := range []string{"menu.yaml", "menu.yml", "menu.toml"} {
+		if _, err := FormatFromPath(path); err == nil {
+			t.Errorf("FormatFromPath(%q) should fail", path)
+		}
+	}
+}
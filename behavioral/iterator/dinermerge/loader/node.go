@@ -0,0 +1,45 @@
+package loader
+
+type nodeKind int
+
+const (
+	scalarNode nodeKind = iota
+	mappingNode
+	sequenceNode
+)
+
+type scalarKind int
+
+const (
+	stringScalar scalarKind = iota
+	numberScalar
+	boolScalar
+	nullScalar
+)
+
+// node es un árbol neutral al formato que conserva el número de línea de
+// cada valor, para que los errores de validación apunten al archivo.
+type node struct {
+	kind   nodeKind
+	line   int
+	value  string
+	scalar scalarKind
+	keys   []string
+	fields map[string]*node
+	items  []*node
+}
+
+func newMapping(line int) *node {
+	return &node{kind: mappingNode, line: line, fields: make(map[string]*node)}
+}
+
+// set agrega un campo; line es la línea de la clave, para que una clave
+// repetida se informe donde aparece la segunda vez.
+func (n *node) set(key string, value *node, line int) error {
+	if _, exists := n.fields[key]; exists {
+		return &ValidationError{Line: line, Path: key, Message: "duplicate key"}
+	}
+	n.keys = append(n.keys, key)
+	n.fields[key] = value
+	return nil
+}
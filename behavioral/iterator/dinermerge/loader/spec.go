@@ -0,0 +1,25 @@
+package loader
+
+import "encoding/json"
+
+type ItemSpec struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Price       json.Number `json:"price"`
+	Vegetarian  bool        `json:"vegetarian,omitempty"`
+	Tags        []string    `json:"tags,omitempty"`
+	Allergens   []string    `json:"allergens,omitempty"`
+	Available   []string    `json:"available,omitempty"`
+}
+
+// MenuSpec es la forma en disco de un menú: ítems propios y secciones,
+// que a su vez son menús y se cargan como submenús. Currency se hereda
+// de la sección padre y por defecto es USD.
+type MenuSpec struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Currency    string     `json:"currency,omitempty"`
+	Available   []string   `json:"available,omitempty"`
+	Items       []ItemSpec `json:"items,omitempty"`
+	Sections    []MenuSpec `json:"sections,omitempty"`
+}
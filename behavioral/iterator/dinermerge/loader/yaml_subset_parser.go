@@ -0,0 +1,315 @@
+package loader
+
+import (
+	"strconv"
+	"strings"
+)
+
+// El formato YAMLSubset no es YAML completo: cubre lo que usan los
+// archivos de menús, es decir mapas y secuencias por indentación,
+// secuencias en línea de escalares ([a, b]), escalares de una línea,
+// simples o entre comillas, y comentarios con #. Todo lo demás (anclas,
+// alias, etiquetas, bloques multilínea, mapas en línea, colecciones
+// anidadas en línea, varios documentos) es un error, nunca se adivina.
+
+type yamlLine struct {
+	number int
+	indent int
+	text   string
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	pos   int
+}
+
+func parseYAMLSubset(data []byte) (*node, error) {
+	p := &yamlParser{}
+	for i, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(raw, " \t\r")
+		leading := raw[:len(raw)-len(strings.TrimLeft(raw, " \t"))]
+		if strings.Contains(leading, "\t") {
+			return nil, &ValidationError{Line: i + 1, Message: "tabs are not allowed for indentation"}
+		}
+		text := stripComment(strings.TrimLeft(raw, " "))
+		if text == "" {
+			continue
+		}
+		if text == "---" || text == "..." {
+			if len(p.lines) > 0 {
+				return nil, &ValidationError{Line: i + 1, Message: "multiple documents are not supported"}
+			}
+			continue
+		}
+		p.lines = append(p.lines, yamlLine{number: i + 1, indent: len(raw) - len(strings.TrimLeft(raw, " ")), text: text})
+	}
+
+	if len(p.lines) == 0 {
+		return &node{kind: mappingNode, line: 1, fields: map[string]*node{}}, nil
+	}
+	root, err := p.parseBlock(p.lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, &ValidationError{Line: p.lines[p.pos].number, Message: "unexpected indentation"}
+	}
+	return root, nil
+}
+
+func (p *yamlParser) parseBlock(indent int) (*node, error) {
+	if isSequenceEntry(p.lines[p.pos].text) {
+		return p.parseSequence(indent)
+	}
+	return p.parseMapping(indent)
+}
+
+func (p *yamlParser) parseSequence(indent int) (*node, error) {
+	sequence := &node{kind: sequenceNode, line: p.lines[p.pos].number}
+
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSequenceEntry(p.lines[p.pos].text) {
+		line := p.lines[p.pos]
+		content := strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " ")
+
+		if content == "" {
+			p.pos++
+			child, err := p.parseNested(indent, line.number)
+			if err != nil {
+				return nil, err
+			}
+			sequence.items = append(sequence.items, child)
+			continue
+		}
+
+		// "- clave: valor" abre un mapa cuyo contenido queda alineado
+		// con la clave, así que se reescribe la línea con esa indentación.
+		if _, _, isPair := splitPair(content); isPair || isSequenceEntry(content) {
+			childIndent := indent + len(line.text) - len(content)
+			p.lines[p.pos] = yamlLine{number: line.number, indent: childIndent, text: content}
+			child, err := p.parseBlock(childIndent)
+			if err != nil {
+				return nil, err
+			}
+			sequence.items = append(sequence.items, child)
+			continue
+		}
+
+		value, err := parseInlineValue(content, line.number)
+		if err != nil {
+			return nil, err
+		}
+		sequence.items = append(sequence.items, value)
+		p.pos++
+	}
+
+	return sequence, nil
+}
+
+func (p *yamlParser) parseMapping(indent int) (*node, error) {
+	mapping := newMapping(p.lines[p.pos].number)
+
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent {
+		line := p.lines[p.pos]
+		if isSequenceEntry(line.text) {
+			return nil, &ValidationError{Line: line.number, Message: "unexpected sequence entry inside a mapping"}
+		}
+		key, value, ok := splitPair(line.text)
+		if !ok {
+			return nil, &ValidationError{Line: line.number, Message: "expected \"key: value\""}
+		}
+		p.pos++
+
+		if value != "" {
+			child, err := parseInlineValue(value, line.number)
+			if err != nil {
+				return nil, err
+			}
+			if err := mapping.set(key, child, line.number); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// YAML permite que una secuencia quede a la misma altura que su clave
+		if p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSequenceEntry(p.lines[p.pos].text) {
+			child, err := p.parseSequence(indent)
+			if err != nil {
+				return nil, err
+			}
+			if err := mapping.set(key, child, line.number); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		child, err := p.parseNested(indent, line.number)
+		if err != nil {
+			return nil, err
+		}
+		if err := mapping.set(key, child, line.number); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+		return nil, &ValidationError{Line: p.lines[p.pos].number, Message: "unexpected indentation"}
+	}
+	return mapping, nil
+}
+
+func (p *yamlParser) parseNested(parentIndent int, line int) (*node, error) {
+	if p.pos >= len(p.lines) || p.lines[p.pos].indent <= parentIndent {
+		return &node{kind: scalarNode, line: line, scalar: nullScalar}, nil
+	}
+	return p.parseBlock(p.lines[p.pos].indent)
+}
+
+func isSequenceEntry(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// splitPair separa "clave: valor" ignorando los dos puntos entre comillas.
+func splitPair(text string) (string, string, bool) {
+	var quote rune
+	for i, r := range text {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ':' && (i == len(text)-1 || text[i+1] == ' '):
+			key := strings.TrimSpace(text[:i])
+			if unquoted, err := unquote(key); err == nil {
+				key = unquoted
+			}
+			return key, strings.TrimSpace(text[i+1:]), key != ""
+		}
+	}
+	return "", "", false
+}
+
+func stripComment(text string) string {
+	var quote rune
+	for i, r := range text {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == '#' && (i == 0 || text[i-1] == ' '):
+			return strings.TrimSpace(text[:i])
+		}
+	}
+	return text
+}
+
+func parseInlineValue(text string, line int) (*node, error) {
+	if strings.HasPrefix(text, "[") {
+		if !strings.HasSuffix(text, "]") {
+			return nil, &ValidationError{Line: line, Message: "unterminated inline sequence"}
+		}
+		sequence := &node{kind: sequenceNode, line: line}
+		inner := strings.TrimSpace(text[1 : len(text)-1])
+		if inner == "" {
+			return sequence, nil
+		}
+		for _, part := range splitInline(inner) {
+			part = strings.TrimSpace(part)
+			if strings.HasPrefix(part, "[") || strings.HasPrefix(part, "{") {
+				return nil, &ValidationError{Line: line, Message: "nested inline collections are not supported"}
+			}
+			item, err := parseScalar(part, line)
+			if err != nil {
+				return nil, err
+			}
+			sequence.items = append(sequence.items, item)
+		}
+		return sequence, nil
+	}
+	if strings.HasPrefix(text, "{") {
+		return nil, &ValidationError{Line: line, Message: "inline mappings are not supported"}
+	}
+	return parseScalar(text, line)
+}
+
+func splitInline(text string) []string {
+	var parts []string
+	var quote rune
+	start := 0
+	for i, r := range text {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+		case r == ',':
+			parts = append(parts, text[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, text[start:])
+}
+
+func parseScalar(text string, line int) (*node, error) {
+	if message, unsupported := unsupportedScalar(text); unsupported {
+		return nil, &ValidationError{Line: line, Message: message}
+	}
+	if strings.HasPrefix(text, "\"") || strings.HasPrefix(text, "'") {
+		value, err := unquote(text)
+		if err != nil {
+			return nil, &ValidationError{Line: line, Message: "invalid quoted string " + text}
+		}
+		return &node{kind: scalarNode, line: line, value: value, scalar: stringScalar}, nil
+	}
+
+	n := &node{kind: scalarNode, line: line, value: text, scalar: stringScalar}
+	switch strings.ToLower(text) {
+	case "null", "~":
+		n.scalar = nullScalar
+	case "true", "false":
+		n.scalar = boolScalar
+		n.value = strings.ToLower(text)
+	default:
+		if _, err := strconv.ParseFloat(text, 64); err == nil {
+			n.scalar = numberScalar
+		}
+	}
+	return n, nil
+}
+
+func unquote(text string) (string, error) {
+	if len(text) >= 2 && text[0] == '\'' && text[len(text)-1] == '\'' {
+		return strings.ReplaceAll(text[1:len(text)-1], "''", "'"), nil
+	}
+	if len(text) >= 2 && text[0] == '"' {
+		return strconv.Unquote(text)
+	}
+	return text, nil
+}
+
+// unsupportedScalar detecta construcciones YAML que el subconjunto no
+// interpreta y que de otro modo se leerían como texto.
+func unsupportedScalar(text string) (string, bool) {
+	if text == "" {
+		return "", false
+	}
+	switch text[0] {
+	case '&':
+		return "anchors are not supported", true
+	case '*':
+		return "aliases are not supported", true
+	case '!':
+		return "tags are not supported", true
+	case '|', '>':
+		return "block scalars are not supported", true
+	case '@', '`':
+		return "plain scalars cannot start with " + text[:1], true
+	}
+	return "", false
+}
@@ -0,0 +1,110 @@
+package loader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseYAMLSubset(t *testing.T) {
+	data := strings.Join([]string{
+		"# comentario",
+		"---",
+		"name: \"Cafe: nights\"",
+		"'quoted key': value # comentario al final",
+		"tags: [vegan, \"nut-free\"]",
+		"empty: []",
+		"flag: true",
+		"price: 3.50",
+		"nothing: ~",
+		"list:",
+		"- a",
+		"- b",
+		"items:",
+		"  - name: Soup",
+		"    price: 3",
+		"  -",
+		"    name: Salad",
+	}, "\n")
+
+	root, err := parseYAMLSubset([]byte(data))
+	if err != nil {
+		t.Fatalf("parseYAMLSubset: %v", err)
+	}
+
+	scalars := []struct {
+		key    string
+		value  string
+		scalar scalarKind
+		line   int
+	}{
+		{"name", "Cafe: nights", stringScalar, 3},
+		{"quoted key", "value", stringScalar, 4},
+		{"flag", "true", boolScalar, 7},
+		{"price", "3.50", numberScalar, 8},
+		{"nothing", "~", nullScalar, 9},
+	}
+	for _, s := range scalars {
+		n, ok := root.fields[s.key]
+		if !ok {
+			t.Fatalf("missing key %q in %v", s.key, root.keys)
+		}
+		if n.kind != scalarNode || n.value != s.value || n.scalar != s.scalar || n.line != s.line {
+			t.Errorf("%s = %+v, want %q (kind %d) on line %d", s.key, n, s.value, s.scalar, s.line)
+		}
+	}
+
+	if tags := root.fields["tags"]; len(tags.items) != 2 || tags.items[1].value != "nut-free" {
+		t.Errorf("tags = %+v", tags.items)
+	}
+	if empty := root.fields["empty"]; empty.kind != sequenceNode || len(empty.items) != 0 {
+		t.Errorf("empty = %+v", empty)
+	}
+	if list := root.fields["list"]; list.kind != sequenceNode || len(list.items) != 2 {
+		t.Errorf("list = %+v", list)
+	}
+	items := root.fields["items"].items
+	if len(items) != 2 || items[0].fields["name"].value != "Soup" || items[1].fields["name"].value != "Salad" {
+		t.Errorf("items = %+v", items)
+	}
+	if line := items[1].fields["name"].line; line != 17 {
+		t.Errorf("Salad on line %d, want 17", line)
+	}
+}
+
+func TestParseYAMLSubsetRejectsUnsupportedSyntax(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		line int
+		want string
+	}{
+		{"anchor", "base: &base Soup", 1, "anchors"},
+		{"alias", "name: Soup\ncopy: *base", 2, "aliases"},
+		{"tag", "price: !!str 3", 1, "tags"},
+		{"literal block", "description: |\n  line one\n  line two", 1, "block scalars"},
+		{"folded block", "description: >\n  folded", 1, "block scalars"},
+		{"flow mapping", "item: {name: Soup}", 1, "inline mappings"},
+		{"nested flow", "tags: [[vegan], spicy]", 1, "nested inline"},
+		{"unterminated flow", "tags: [vegan,\n  spicy]", 1, "unterminated"},
+		{"multi-line plain scalar", "description: a long\n  description", 2, "unexpected indentation"},
+		{"multiple documents", "name: A\n---\nname: B", 2, "multiple documents"},
+		{"tabs", "items:\n\t- name: Soup", 2, "tabs"},
+		{"duplicate key", "name: A\nname: B", 2, "duplicate key"},
+		{"missing colon", "name A", 1, "key: value"},
+		{"bad quote", "name: \"Soup", 1, "quoted string"},
+		{"sequence inside mapping", "name: A\n- b", 2, "sequence entry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseYAMLSubset([]byte(tt.data))
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("err = %v, want *ValidationError", err)
+			}
+			if ve.Line != tt.line || !strings.Contains(ve.Message, tt.want) {
+				t.Fatalf("err = %v, want line %d mentioning %q", err, tt.line, tt.want)
+			}
+		})
+	}
+}
@@ -1,15 +1,264 @@
 package main
 
 import (
+	"bytes"
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/loader"
 	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/behavioral/iterator/dinermerge/order"
+	"designpatterns/behavioral/iterator/dinermerge/render"
+	"designpatterns/behavioral/iterator/dinermerge/search"
+	"designpatterns/behavioral/iterator/dinermerge/versioning"
 	"designpatterns/behavioral/iterator/dinermerge/waitress"
+	"designpatterns/internal/clock"
+	"designpatterns/internal/money"
+	_ "embed"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
 )
 
+//go:embed data/cafe_menu.yaml
+var cafeMenuYAML []byte
+
 func main() {
+	// Reloj fijo para que la demo no dependa de la hora real
+	restaurantClock := clock.NewFakeClock(time.Date(2026, time.October, 1, 9, 0, 0, 0, time.Local))
+
 	pancakeHouseMenu := menu.NewPancakeHouseMenu()
 	dinerMenu := menu.NewDinerMenu()
+	// Cada cambio hecho a través de VersionedMenu queda como una versión nueva
+	dinerVersions := versioning.NewVersionedMenu("DINER", dinerMenu, restaurantClock)
+
+	// El menú del diner tiene capacidad fija: agregar de más devuelve un error
+	clubSandwich := models.NewMenuItem("Club Sandwich", "Triple-decker with turkey and bacon", false, money.USD(3.49))
+	if err := dinerVersions.AddMenuItem(clubSandwich); err != nil {
+		var fullErr *menu.MenuFullError
+		if errors.As(err, &fullErr) {
+			fmt.Println("No se pudo agregar:", err)
+		}
+	}
+	restaurantClock.Advance(24 * time.Hour)
+	dinerVersions.RemoveItem("Hotdog")
+	dinerVersions.AddMenuItem(clubSandwich)
+	restaurantClock.Advance(7 * 24 * time.Hour)
+	dinerVersions.UpdateItem("BLT", models.NewMenuItem("BLT", "Bacon with lettuce & tomato on whole wheat", false, money.USD(3.29)).
+		WithTags(models.TagNutFree).
+		WithAllergens(models.AllergenGluten))
+
+	// Menús compuestos: cada sección puede contener ítems y submenús
+	breakfast := menu.NewCompositeMenu("BREAKFAST", "Pancake House").WithAvailability(models.BreakfastHours)
+	breakfast.AddSubMenu(pancakeHouseMenu)
+
+	dessertMenu := menu.NewCompositeMenu("DESSERT", "Dessert of course!")
+	dessertMenu.AddItem(models.NewMenuItem("Apple Pie", "Apple pie with a flakey crust, topped with vanilla ice cream", true, money.USD(1.59)).
+		WithAllergens(models.AllergenGluten, models.AllergenDairy))
+	dessertMenu.AddItem(models.NewMenuItem("Cheesecake", "Creamy New York cheesecake, with a chocolate graham crust", true, money.USD(1.99)).
+		WithAllergens(models.AllergenGluten, models.AllergenDairy, models.AllergenEggs))
+
+	lunch := menu.NewCompositeMenu("LUNCH", "Diner").WithAvailability(models.LunchHours)
+	lunch.AddSubMenu(dinerMenu)
+	lunch.AddSubMenu(dessertMenu)
 
-	waitress := waitress.NewWaitress(pancakeHouseMenu, dinerMenu)
+	// El menú del café se carga desde un archivo YAML embebido
+	cafeMenu, err := loader.Load(bytes.NewReader(cafeMenuYAML), loader.YAMLSubset)
+	if err != nil {
+		fmt.Println("Error loading cafe menu:", err)
+		return
+	}
+
+	waitress := waitress.NewWaitress(breakfast, lunch, cafeMenu)
+	// Lunes 8:30: solo se sirve el desayuno
+	restaurantClock.Set(time.Date(2026, time.October, 19, 8, 30, 0, 0, time.Local))
+	waitress.SetClock(restaurantClock)
 
 	waitress.PrintMenu()
+
+	// Solo lo que se sirve a la hora del reloj
+	waitress.PrintAvailableMenu()
+	restaurantClock.Set(time.Date(2026, time.October, 17, 18, 30, 0, 0, time.Local))
+	waitress.PrintAvailableMenu()
+
+	// Consultas dietarias sobre todos los menús
+	waitress.PrintVegetarianMenu()
+	waitress.PrintDietaryMenu(models.DietaryQuery{
+		Require: []models.DietaryTag{models.TagVegan},
+		Exclude: []models.Allergen{models.AllergenGluten},
+	})
+	for _, name := range []string{"Pasta", "BLT", "Lasagna"} {
+		vegetarian, err := waitress.IsItemVegetarian(name)
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
+		}
+		fmt.Printf("¿%s es vegetariano? %t\n", name, vegetarian)
+	}
+
+	// Iteradores range-over-func (Go 1.23+)
+	fmt.Println("\nPlatos del almuerzo por sección:")
+	for section, item := range lunch.Sections() {
+		fmt.Printf("  [%s] %s\n", section, item.Name)
+	}
+
+	fmt.Println("\nDesayunos numerados:")
+	for i, item := range pancakeHouseMenu.Indexed() {
+		fmt.Printf("  %d. %s\n", i+1, item.Name)
+	}
+
+	// Combinadores: vegetarianos de menos de $3.50 de todos los menús, por precio
+	fmt.Println("\nVegetarianos de menos de $3.50, ordenados por precio:")
+	cheapVegetarian := iterator.Sorted(
+		iterator.Filter(waitress.AllItems(), func(item *models.MenuItem) bool {
+			return item.IsVegetarian() && item.GetPrice().LessThan(money.USD(3.50))
+		}),
+		func(a, b *models.MenuItem) int { return a.GetPrice().Cmp(b.GetPrice()) },
+	)
+	waitress.PrintItems(cheapVegetarian)
+
+	// Money: totales exactos en centavos y formato por locale
+	lunchTotal := money.Zero(money.CurrencyUSD)
+	for item := range lunch.All() {
+		lunchTotal = lunchTotal.Add(item.GetPrice())
+	}
+	fmt.Println("\nLunch total:", lunchTotal, "/", lunchTotal.Format(money.EsES))
+	if shares, err := lunchTotal.Split(3); err == nil {
+		fmt.Println("Split in 3:", shares)
+	}
+
+	// Adaptador de iter.Seq a Iterator
+	dessertIterator := iterator.FromSeq(dessertMenu.All())
+	defer dessertIterator.Stop()
+	if dessertIterator.HasNext() {
+		first, _ := dessertIterator.Next()
+		fmt.Println("\nPrimer postre:", first.Name)
+	}
+
+	// Búsqueda tolerante a errores de tipeo, acentos y mayúsculas
+	index := search.NewIndex(waitress.Menus()...)
+	for _, text := range []string{"pancake", "pankake", "SÓUP"} {
+		fmt.Printf("\nSearch %q:\n", text)
+		for _, result := range index.Find(text) {
+			fmt.Printf("  %-32s %-10s %5.1f\n", result.Item.Name, result.Menu, result.Score)
+		}
+	}
+	fmt.Println("\nVegan dishes up to $4.00:")
+	maxPrice := money.USD(4)
+	for _, result := range index.Search(search.Query{Tags: []models.DietaryTag{models.TagVegan}, MaxPrice: &maxPrice}) {
+		fmt.Printf("  %-32s %-10s %s\n", result.Item.Name, result.Menu, result.Item.GetPrice())
+	}
+
+	// Pedidos: abrir mesa, agregar platos por nombre y cobrar
+	// A las 12:30 solo se sirve el almuerzo: los pancakes se rechazan
+	restaurantClock.Set(time.Date(2026, time.October, 19, 12, 30, 0, 0, time.Local))
+	orders, err := order.NewService(waitress, 0.0825)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	orders.SetClock(restaurantClock)
+	orders.OpenTable(4, 3)
+	orders.AddItem(4, "blt", 2, "no tomato")
+	orders.AddItem(4, "Pasta", 1, "extra parmesan")
+	orders.AddItem(4, "Apple Pie", 1, "")
+	if _, err := orders.AddItem(4, "Lobster", 1, ""); err != nil {
+		fmt.Println("\nCould not add item:", err)
+	}
+	if _, err := orders.AddItem(4, "Blueberry Pancakes", 1, ""); err != nil {
+		fmt.Println("Could not add item:", err)
+	}
+	if bill, err := orders.CloseTable(4, 0.15); err == nil {
+		fmt.Println(bill)
+		shares, _ := bill.Split(3)
+		fmt.Println("Each diner pays:", shares)
+	}
+	if _, err := orders.AddItem(4, "Waffles", 1, ""); err != nil {
+		fmt.Println("Could not add item:", err)
+	}
+
+	// Menús seguros para concurrencia: cada iterador recorre una copia
+	specials := menu.NewPancakeHouseMenu()
+	var wg sync.WaitGroup
+	for i := range 3 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			specials.AddItem(fmt.Sprintf("Special #%d", i+1), "Chef's pancake of the day", true, money.USD(3.99))
+		}()
+		go func() {
+			defer wg.Done()
+			for range specials.All() {
+			}
+		}()
+	}
+	wg.Wait()
+	fmt.Println("\nPancake menu items after concurrent edits:", specials.Len())
+
+	// Modo fail-fast: el iterador avisa si el menú cambió mientras se recorría
+	specials.SetFailFast(true)
+	browsing := specials.CreateIterator()
+	browsing.Next()
+	specials.AddItem("Pumpkin Pancakes", "Pancakes with pumpkin spice", true, money.USD(3.79))
+	if _, err := browsing.Next(); errors.Is(err, iterator.ErrConcurrentModification) {
+		fmt.Println("Iteration stopped:", err)
+	}
+
+	// Renderers: el mismo documento en Markdown, HTML y JSON
+	dessertDoc, _ := render.NewDocument("Desserts", render.DefaultOptions(), dessertMenu)
+	for _, format := range []string{"markdown", "html", "json"} {
+		renderer, err := render.ForFormat(format)
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
+		}
+		fmt.Printf("\n--- %s ---\n", format)
+		renderer.Render(os.Stdout, dessertDoc)
+	}
+
+	// Versiones del menú del diner: historial, diff y el menú en una versión anterior
+	fmt.Println("\nDiner menu versions:")
+	for _, version := range dinerVersions.Versions() {
+		fmt.Printf("  v%d %s  %s\n", version.Number, version.Time.Format("2006-01-02"), version.Summary())
+	}
+	for _, entry := range dinerVersions.History("BLT") {
+		fmt.Printf("BLT changed in v%d on %s: %s\n", entry.Version, entry.Time.Format("2006-01-02"), entry.Change)
+	}
+	if changes, err := dinerVersions.Diff(1, dinerVersions.CurrentVersion()); err == nil {
+		fmt.Println("Changes since v1:")
+		for _, change := range changes {
+			fmt.Println("  " + change.String())
+		}
+	}
+	if original, err := dinerVersions.At(1); err == nil {
+		originalDoc, _ := render.NewDocument("", render.DefaultOptions(), original)
+		render.NewTextRenderer().Render(os.Stdout, originalDoc)
+	}
+
+	// Cursores: navegación en ambos sentidos y borrado real en el menú
+	editorMenu := menu.NewPancakeHouseMenu()
+	cursor := editorMenu.CreateCursor()
+	cursor.Next()
+	cursor.Next()
+	if previous, err := cursor.Previous(); err == nil {
+		fmt.Println("\nCursor moved back to:", previous.Name)
+	}
+	if err := cursor.Remove(); err == nil {
+		peeked, _ := cursor.Peek()
+		fmt.Println("Removed it; next up is:", peeked.Name, "- menu now has", editorMenu.Len(), "items")
+	}
+	if err := breakfast.CreateCursor().Remove(); errors.Is(err, iterator.ErrUnsupportedOperation) {
+		fmt.Println("Composite menu cursor:", err)
+	}
+
+	// Exportar un menú y validar un archivo con errores
+	fmt.Println("\nMenú de postres exportado como YAMLSubset:")
+	loader.Export(os.Stdout, dessertMenu, loader.YAMLSubset)
+
+	broken := []byte("name: SPECIALS\nitems:\n  - name: Waffles\n    price: -2\n    tags: [keto]\n")
+	if _, err := loader.Parse(broken, loader.YAMLSubset); err != nil {
+		fmt.Println("\nInvalid menu file:")
+		fmt.Println(err)
+	}
 }
@@ -0,0 +1,189 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// Entry es un hijo de un CompositeMenu: un ítem o un submenú.
+type Entry struct {
+	Item    *models.MenuItem
+	SubMenu Menu
+}
+
+type CompositeMenu struct {
+	guard
+	name        string
+	description string
+	entries     []Entry
+	available   models.Availability
+}
+
+func NewCompositeMenu(name, description string) *CompositeMenu {
+	return &CompositeMenu{
+		name:        name,
+		description: description,
+	}
+}
+
+func (c *CompositeMenu) GetName() string {
+	return c.name
+}
+
+func (c *CompositeMenu) GetDescription() string {
+	return c.description
+}
+
+// WithAvailability limita el menú completo a ciertos horarios, por
+// ejemplo el desayuno de 7 a 11.
+func (c *CompositeMenu) WithAvailability(windows ...models.Window) *CompositeMenu {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.available = append(c.available, windows...)
+	return c
+}
+
+func (c *CompositeMenu) Availability() models.Availability {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append(models.Availability(nil), c.available...)
+}
+
+func (c *CompositeMenu) IsAvailableAt(t time.Time) bool {
+	return c.Availability().IsAvailableAt(t)
+}
+
+func (c *CompositeMenu) AddItem(item *models.MenuItem) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, Entry{Item: item})
+	c.modified()
+}
+
+// AddSubMenu devuelve ErrMenuCycle si el submenú es el propio menú o ya
+// lo contiene: el recorrido en profundidad no terminaría nunca.
+func (c *CompositeMenu) AddSubMenu(menu Menu) error {
+	if sub, ok := menu.(*CompositeMenu); ok && (sub == c || sub.contains(c)) {
+		return fmt.Errorf("%w: %s inside %s", ErrMenuCycle, sub.name, c.name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = append(c.entries, Entry{SubMenu: menu})
+	c.modified()
+	return nil
+}
+
+// contains indica si target está en algún nivel del árbol de c.
+func (c *CompositeMenu) contains(target *CompositeMenu) bool {
+	for _, entry := range c.Entries() {
+		if sub, ok := entry.SubMenu.(*CompositeMenu); ok && (sub == target || sub.contains(target)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CompositeMenu) Entries() []Entry {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entries := make([]Entry, len(c.entries))
+	copy(entries, c.entries)
+	return entries
+}
+
+// CreateIterator toma una copia de las entradas; cada submenú aporta su
+// propio iterador, que a su vez es una copia de sus ítems.
+func (c *CompositeMenu) CreateIterator() iterator.Iterator[*models.MenuItem] {
+	var iterators []iterator.Iterator[*models.MenuItem]
+	var pending []*models.MenuItem
+
+	for _, entry := range c.Entries() {
+		if entry.SubMenu == nil {
+			pending = append(pending, entry.Item)
+			continue
+		}
+		if len(pending) > 0 {
+			iterators = append(iterators, iterator.NewSliceIterator(pending))
+			pending = nil
+		}
+		iterators = append(iterators, entry.SubMenu.CreateIterator())
+	}
+	if len(pending) > 0 {
+		iterators = append(iterators, iterator.NewSliceIterator(pending))
+	}
+
+	return c.iterator(iterator.NewCompositeIterator(iterators...), c.ModCount)
+}
+
+// ModCount suma el contador propio y el de cada submenú que implementa
+// ModCounter, así el modo fail-fast también detecta cambios dentro de los
+// submenús. Los cambios en submenús sin contador no se detectan.
+func (c *CompositeMenu) ModCount() uint64 {
+	total := c.guard.ModCount()
+	for _, entry := range c.Entries() {
+		if counter, ok := entry.SubMenu.(ModCounter); ok {
+			total += counter.ModCount()
+		}
+	}
+	return total
+}
+
+// CreateCursor recorre el árbol completo, pero Remove devuelve
+// iterator.ErrUnsupportedOperation: un ítem puede venir de cualquier
+// submenú, y no todos se pueden editar.
+func (c *CompositeMenu) CreateCursor() iterator.Cursor[*models.MenuItem] {
+	var items []*models.MenuItem
+	for item := range c.All() {
+		items = append(items, item)
+	}
+	return iterator.NewSliceCursor(items, nil)
+}
+
+func (c *CompositeMenu) All() iter.Seq[*models.MenuItem] {
+	return all(c)
+}
+
+func (c *CompositeMenu) Indexed() iter.Seq2[int, *models.MenuItem] {
+	return indexed(c)
+}
+
+// Sections recorre el árbol en profundidad indicando el nombre del menú
+// con nombre más cercano que contiene cada ítem.
+func (c *CompositeMenu) Sections() iter.Seq2[string, *models.MenuItem] {
+	return func(yield func(string, *models.MenuItem) bool) {
+		c.yieldSections(c.name, yield)
+	}
+}
+
+func (c *CompositeMenu) yieldSections(section string, yield func(string, *models.MenuItem) bool) bool {
+	for _, entry := range c.Entries() {
+		if entry.SubMenu == nil {
+			if !yield(section, entry.Item) {
+				return false
+			}
+			continue
+		}
+
+		switch sub := entry.SubMenu.(type) {
+		case *CompositeMenu:
+			if !sub.yieldSections(sub.name, yield) {
+				return false
+			}
+		default:
+			subSection := section
+			if named, ok := sub.(NamedMenu); ok {
+				subSection = named.GetName()
+			}
+			for item := range sub.All() {
+				if !yield(subSection, item) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func names(m Menu) []string {
+	var result []string
+	it := m.CreateIterator()
+	for it.HasNext() {
+		item, _ := it.Next()
+		result = append(result, item.Name)
+	}
+	return result
+}
+
+func TestCompositeMenuIteratesDepthFirst(t *testing.T) {
+	desserts := NewCompositeMenu("Desserts", "")
+	desserts.AddItem(models.NewMenuItem("Apple Pie", "", true, money.USD(1.59)))
+
+	cafe := NewCompositeMenu("Cafe", "")
+	cafe.AddItem(models.NewMenuItem("Coffee", "", true, money.USD(0.99)))
+	cafe.AddSubMenu(desserts)
+	cafe.AddSubMenu(NewCompositeMenu("Empty", ""))
+	cafe.AddItem(models.NewMenuItem("Tea", "", true, money.USD(0.89)))
+
+	all := NewCompositeMenu("All", "")
+	all.AddSubMenu(NewPancakeHouseMenu())
+	all.AddSubMenu(cafe)
+
+	want := []string{
+		"K&B's Pancake Breakfast", "Regular Pancake Breakfast", "Blueberry Pancakes", "Waffles",
+		"Coffee", "Apple Pie", "Tea",
+	}
+	if got := names(all); !slices.Equal(got, want) {
+		t.Fatalf("items = %q, want %q", got, want)
+	}
+}
+
+func TestCompositeMenuEntriesIsACopy(t *testing.T) {
+	m := NewCompositeMenu("Cafe", "")
+	m.AddItem(models.NewMenuItem("Coffee", "", true, money.USD(0.99)))
+
+	entries := m.Entries()
+	entries[0] = Entry{SubMenu: NewDinerMenu()}
+	if got := names(m); !slices.Equal(got, []string{"Coffee"}) {
+		t.Fatalf("editing Entries changed the menu: %q", got)
+	}
+}
+
+func TestCompositeMenuCursorCannotRemove(t *testing.T) {
+	m := NewCompositeMenu("All", "")
+	m.AddSubMenu(NewDinerMenu())
+	cursor := m.CreateCursor()
+	if _, err := cursor.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if err := cursor.Remove(); !errors.Is(err, iterator.ErrUnsupportedOperation) {
+		t.Fatalf("err = %v, want ErrUnsupportedOperation", err)
+	}
+}
+
+func TestAddSubMenuRejectsCycles(t *testing.T) {
+	all := NewCompositeMenu("All", "")
+	cafe := NewCompositeMenu("Cafe", "")
+	desserts := NewCompositeMenu("Desserts", "")
+	all.AddSubMenu(cafe)
+	cafe.AddSubMenu(desserts)
+
+	tests := []struct {
+		name   string
+		parent *CompositeMenu
+		child  *CompositeMenu
+	}{
+		{"itself", all, all},
+		{"parent", cafe, all},
+		{"grandparent", desserts, all},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.parent.AddSubMenu(tt.child); !errors.Is(err, ErrMenuCycle) {
+				t.Fatalf("err = %v, want ErrMenuCycle", err)
+			}
+		})
+	}
+
+	// El mismo submenú en dos ramas no es un ciclo
+	if err := all.AddSubMenu(desserts); err != nil {
+		t.Fatalf("AddSubMenu: %v", err)
+	}
+	if got := len(all.Entries()); got != 2 {
+		t.Fatalf("len(Entries) = %d, want 2", got)
+	}
+}
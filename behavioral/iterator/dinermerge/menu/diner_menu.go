@@ -3,39 +3,143 @@ package menu
 import (
 	"designpatterns/behavioral/iterator/dinermerge/iterator"
 	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"fmt"
+	"iter"
 )
 
+const dinerMenuMaxItems = 6
+
 type DinerMenu struct {
+	guard
 	menuItems []*models.MenuItem
 	maxItems  int
 	itemCount int
 }
 
 func NewDinerMenu() *DinerMenu {
-	menuItems := make([]*models.MenuItem, 6)
-	menuItems[0] = models.NewMenuItem("Vegetarian BLT", "(Fakin') Bacon with lettuce & tomato on whole wheat", true, 2.99)
-	menuItems[1] = models.NewMenuItem("BLT", "Bacon with lettuce & tomato on whole wheat", false, 2.99)
-	menuItems[2] = models.NewMenuItem("Soup of the day", "Soup of the day, with a side of potato salad", false, 3.29)
-	menuItems[3] = models.NewMenuItem("Hotdog", "A hot dog, with sauerkraut, relish, onions, topped with cheese", false, 3.05)
-	menuItems[4] = models.NewMenuItem("Steamed Veggies and Brown Rice", "Steamed vegetables over brown rice", true, 3.99)
-	menuItems[5] = models.NewMenuItem("Pasta", "Spaghetti with Marinara Sauce, and a slice of sourdough bread", true, 3.89)
-
-	return &DinerMenu{
-		menuItems: menuItems,
-		maxItems:  6,
+	d := &DinerMenu{
+		menuItems: make([]*models.MenuItem, dinerMenuMaxItems),
+		maxItems:  dinerMenuMaxItems,
 		itemCount: 0,
 	}
+
+	d.AddMenuItem(models.NewMenuItem("Vegetarian BLT", "(Fakin') Bacon with lettuce & tomato on whole wheat", true, money.USD(2.99)).
+		WithTags(models.TagVegan, models.TagNutFree).
+		WithAllergens(models.AllergenGluten, models.AllergenSoy))
+	d.AddMenuItem(models.NewMenuItem("BLT", "Bacon with lettuce & tomato on whole wheat", false, money.USD(2.99)).
+		WithTags(models.TagNutFree).
+		WithAllergens(models.AllergenGluten))
+	d.AddMenuItem(models.NewMenuItem("Soup of the day", "Soup of the day, with a side of potato salad", false, money.USD(3.29)).
+		WithTags(models.TagGlutenFree, models.TagNutFree).
+		WithAllergens(models.AllergenEggs))
+	d.AddMenuItem(models.NewMenuItem("Hotdog", "A hot dog, with sauerkraut, relish, onions, topped with cheese", false, money.USD(3.05)).
+		WithAllergens(models.AllergenGluten, models.AllergenDairy))
+	d.AddMenuItem(models.NewMenuItem("Steamed Veggies and Brown Rice", "Steamed vegetables over brown rice", true, money.USD(3.99)).
+		WithTags(models.TagVegan, models.TagGlutenFree, models.TagNutFree))
+	d.AddMenuItem(models.NewMenuItem("Pasta", "Spaghetti with Marinara Sauce, and a slice of sourdough bread", true, money.USD(3.89)).
+		WithTags(models.TagNutFree).
+		WithAllergens(models.AllergenGluten))
+
+	return d
+}
+
+func (d *DinerMenu) AddItem(name, description string, vegetarian bool, price money.Money) error {
+	return d.AddMenuItem(models.NewMenuItem(name, description, vegetarian, price))
 }
 
-func (d *DinerMenu) AddItem(name, description string, vegetarian bool, price float64) {
+func (d *DinerMenu) AddMenuItem(item *models.MenuItem) error {
+	if item == nil {
+		return ErrNilItem
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	if d.itemCount >= d.maxItems {
-		return
+		return &MenuFullError{Menu: "DinerMenu", Capacity: d.maxItems}
+	}
+	if d.indexOf(item.Name) >= 0 {
+		return fmt.Errorf("%w: %s", ErrDuplicateItem, item.Name)
 	}
-	item := models.NewMenuItem(name, description, vegetarian, price)
 	d.menuItems[d.itemCount] = item
 	d.itemCount++
+	d.modified()
+	return nil
+}
+
+func (d *DinerMenu) RemoveItem(name string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	index := d.indexOf(name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, name)
+	}
+
+	copy(d.menuItems[index:], d.menuItems[index+1:d.itemCount])
+	d.itemCount--
+	d.menuItems[d.itemCount] = nil
+	d.modified()
+	return nil
+}
+
+func (d *DinerMenu) UpdateItem(name string, updated *models.MenuItem) error {
+	if updated == nil {
+		return ErrNilItem
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	index := d.indexOf(name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, name)
+	}
+	if other := d.indexOf(updated.Name); other >= 0 && other != index {
+		return fmt.Errorf("%w: %s", ErrDuplicateItem, updated.Name)
+	}
+
+	d.menuItems[index] = updated
+	d.modified()
+	return nil
+}
+
+func (d *DinerMenu) Len() int {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.itemCount
+}
+
+func (d *DinerMenu) Capacity() int {
+	return d.maxItems
 }
 
 func (d *DinerMenu) CreateIterator() iterator.Iterator[*models.MenuItem] {
-	return iterator.NewDinerMenuIterator(d.menuItems)
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.iterator(iterator.NewDinerMenuIterator(snapshot(d.menuItems[:d.itemCount])), d.ModCount)
+}
+
+// CreateCursor permite navegar en ambos sentidos y borrar del menú con
+// Remove.
+func (d *DinerMenu) CreateCursor() iterator.Cursor[*models.MenuItem] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return iterator.NewSliceCursor(snapshot(d.menuItems[:d.itemCount]), func(item *models.MenuItem) error {
+		return d.RemoveItem(item.Name)
+	})
+}
+
+// indexOf se llama con el lock tomado.
+func (d *DinerMenu) indexOf(name string) int {
+	for i := 0; i < d.itemCount; i++ {
+		if d.menuItems[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *DinerMenu) All() iter.Seq[*models.MenuItem] {
+	return all(d)
+}
+
+func (d *DinerMenu) Indexed() iter.Seq2[int, *models.MenuItem] {
+	return indexed(d)
 }
@@ -0,0 +1,16 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+)
+
+// EditableMenu lo implementan DinerMenu y PancakeHouseMenu: se edita por
+// nombre de ítem, con los mismos errores en ambos.
+type EditableMenu interface {
+	Menu
+	AddMenuItem(item *models.MenuItem) error
+	RemoveItem(name string) error
+	UpdateItem(name string, updated *models.MenuItem) error
+	CreateCursor() iterator.Cursor[*models.MenuItem]
+}
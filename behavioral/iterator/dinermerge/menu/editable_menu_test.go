@@ -0,0 +1,124 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func editableMenus() map[string]func() EditableMenu {
+	return map[string]func() EditableMenu{
+		"diner":   func() EditableMenu { return NewDinerMenu() },
+		"pancake": func() EditableMenu { return NewPancakeHouseMenu() },
+	}
+}
+
+func firstName(t *testing.T, m Menu) string {
+	t.Helper()
+	all := names(m)
+	if len(all) < 2 {
+		t.Fatalf("menu has %d items, want at least 2", len(all))
+	}
+	return all[0]
+}
+
+func TestEditableMenuErrors(t *testing.T) {
+	item := func(name string) *models.MenuItem {
+		return models.NewMenuItem(name, "test", true, money.USD(1))
+	}
+	tests := []struct {
+		name string
+		edit func(m EditableMenu, first string, second string) error
+		want error
+	}{
+		{"add nil", func(m EditableMenu, _, _ string) error { return m.AddMenuItem(nil) }, ErrNilItem},
+		{"add duplicate", func(m EditableMenu, first, second string) error {
+			// DinerMenu viene lleno: se libera espacio para llegar al chequeo de nombre
+			m.RemoveItem(second)
+			return m.AddMenuItem(item(first))
+		}, ErrDuplicateItem},
+		{"update nil", func(m EditableMenu, first, _ string) error { return m.UpdateItem(first, nil) }, ErrNilItem},
+		{"update missing", func(m EditableMenu, _, _ string) error { return m.UpdateItem("Nope", item("Nope")) }, ErrItemNotFound},
+		{"rename onto existing", func(m EditableMenu, first, second string) error { return m.UpdateItem(first, item(second)) }, ErrDuplicateItem},
+		{"remove missing", func(m EditableMenu, _, _ string) error { return m.RemoveItem("Nope") }, ErrItemNotFound},
+		{"update keeping name", func(m EditableMenu, first, _ string) error { return m.UpdateItem(first, item(first)) }, nil},
+	}
+	for menuName, newMenu := range editableMenus() {
+		for _, tt := range tests {
+			t.Run(menuName+"/"+tt.name, func(t *testing.T) {
+				m := newMenu()
+				all := names(m)
+				if len(all) < 2 {
+					t.Fatalf("menu has %d items", len(all))
+				}
+				err := tt.edit(m, all[0], all[1])
+				if !errors.Is(err, tt.want) {
+					t.Fatalf("err = %v, want %v", err, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestEditableMenuAddUpdateRemove(t *testing.T) {
+	for menuName, newMenu := range editableMenus() {
+		t.Run(menuName, func(t *testing.T) {
+			m := newMenu()
+			first := firstName(t, m)
+			before := len(names(m))
+
+			if err := m.UpdateItem(first, models.NewMenuItem("Renamed", "test", true, money.USD(1))); err != nil {
+				t.Fatalf("UpdateItem: %v", err)
+			}
+			if got := names(m)[0]; got != "Renamed" {
+				t.Fatalf("first item = %q, want Renamed", got)
+			}
+			if err := m.RemoveItem("Renamed"); err != nil {
+				t.Fatalf("RemoveItem: %v", err)
+			}
+			if got := len(names(m)); got != before-1 {
+				t.Fatalf("len = %d, want %d", got, before-1)
+			}
+		})
+	}
+}
+
+func TestCursorRemoveDeletesFromMenu(t *testing.T) {
+	for menuName, newMenu := range editableMenus() {
+		t.Run(menuName, func(t *testing.T) {
+			m := newMenu()
+			first := firstName(t, m)
+			before := len(names(m))
+
+			cursor := m.CreateCursor()
+			if _, err := cursor.Next(); err != nil {
+				t.Fatal(err)
+			}
+			if err := cursor.Remove(); err != nil {
+				t.Fatalf("Remove: %v", err)
+			}
+			all := names(m)
+			if len(all) != before-1 || slices.Contains(all, first) {
+				t.Fatalf("menu after Remove = %q, want it without %q", all, first)
+			}
+			if cursor.HasPrevious() {
+				t.Fatal("the removed item should not be reachable with Previous")
+			}
+		})
+	}
+}
+
+func TestDinerMenuCapacity(t *testing.T) {
+	d := NewDinerMenu()
+	for i := d.Len(); i < d.Capacity(); i++ {
+		if err := d.AddItem(string(rune('A'+i)), "test", true, money.USD(1)); err != nil {
+			t.Fatalf("AddItem %d: %v", i, err)
+		}
+	}
+	var full *MenuFullError
+	if err := d.AddItem("Overflow", "test", true, money.USD(1)); !errors.As(err, &full) {
+		t.Fatalf("err = %v, want *MenuFullError", err)
+	}
+}
@@ -0,0 +1,23 @@
+package menu
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrItemNotFound    = errors.New("menu item not found")
+	ErrDuplicateItem   = errors.New("menu item already exists")
+	ErrItemUnavailable = errors.New("menu item is not available")
+	ErrNilItem         = errors.New("menu item is nil")
+	ErrMenuCycle       = errors.New("submenu would contain itself")
+)
+
+type MenuFullError struct {
+	Menu     string
+	Capacity int
+}
+
+func (e *MenuFullError) Error() string {
+	return fmt.Sprintf("%s is full: capacity is %d items", e.Menu, e.Capacity)
+}
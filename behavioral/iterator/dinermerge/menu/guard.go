@@ -0,0 +1,51 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"sync"
+	"sync/atomic"
+)
+
+// ModCounter lo implementan los menús que cuentan sus modificaciones.
+type ModCounter interface {
+	ModCount() uint64
+}
+
+// guard protege los ítems de un menú: lecturas y escrituras concurrentes
+// con un RWMutex, y un contador de modificaciones para el modo fail-fast.
+type guard struct {
+	mu       sync.RWMutex
+	modCount atomic.Uint64
+	failFast atomic.Bool
+}
+
+// SetFailFast hace que los iteradores nuevos devuelvan
+// iterator.ErrConcurrentModification si el menú cambia mientras se recorren.
+// Sin este modo los iteradores recorren la copia sin quejarse.
+func (g *guard) SetFailFast(enabled bool) {
+	g.failFast.Store(enabled)
+}
+
+func (g *guard) ModCount() uint64 {
+	return g.modCount.Load()
+}
+
+func (g *guard) modified() {
+	g.modCount.Add(1)
+}
+
+// snapshot copia los ítems; se llama con el lock de lectura tomado.
+func snapshot(items []*models.MenuItem) []*models.MenuItem {
+	snapshot := make([]*models.MenuItem, len(items))
+	copy(snapshot, items)
+	return snapshot
+}
+
+// iterator vigila modCount, que en un CompositeMenu incluye los submenús.
+func (g *guard) iterator(inner iterator.Iterator[*models.MenuItem], modCount func() uint64) iterator.Iterator[*models.MenuItem] {
+	if !g.failFast.Load() {
+		return inner
+	}
+	return iterator.NewFailFastIterator(inner, modCount)
+}
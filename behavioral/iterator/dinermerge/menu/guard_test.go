@@ -0,0 +1,106 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestIteratorsWalkASnapshot(t *testing.T) {
+	diner := NewDinerMenu()
+	dinerItems := diner.CreateIterator()
+	if err := diner.RemoveItem("Hotdog"); err != nil {
+		t.Fatal(err)
+	}
+
+	pancakes := NewPancakeHouseMenu()
+	pancakeItems := pancakes.CreateIterator()
+	pancakes.AddItem("Crepes", "", true, money.USD(4))
+
+	for name, it := range map[string]iterator.Iterator[*models.MenuItem]{"diner": dinerItems, "pancake": pancakeItems} {
+		count := 0
+		for it.HasNext() {
+			if _, err := it.Next(); err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			count++
+		}
+		if want := map[string]int{"diner": 6, "pancake": 4}[name]; count != want {
+			t.Errorf("%s iterator saw %d items, want the %d it started with", name, count, want)
+		}
+	}
+}
+
+func TestFailFastIterator(t *testing.T) {
+	m := NewPancakeHouseMenu()
+	m.SetFailFast(true)
+	it := m.CreateIterator()
+	if _, err := it.Next(); err != nil {
+		t.Fatal(err)
+	}
+	m.AddItem("Crepes", "", true, money.USD(4))
+	if _, err := it.Next(); !errors.Is(err, iterator.ErrConcurrentModification) {
+		t.Fatalf("err = %v, want ErrConcurrentModification", err)
+	}
+}
+
+// Con -race este test detecta accesos sin lock entre escritores y lectores.
+func TestCompositeFailFastWatchesSubMenus(t *testing.T) {
+	diner := NewDinerMenu()
+	lunch := NewCompositeMenu("Lunch", "")
+	lunch.AddSubMenu(diner)
+	all := NewCompositeMenu("All", "")
+	all.AddSubMenu(NewPancakeHouseMenu())
+	all.AddSubMenu(lunch)
+	all.SetFailFast(true)
+
+	it := all.CreateIterator()
+	if _, err := it.Next(); err != nil {
+		t.Fatal(err)
+	}
+	// El cambio ocurre dos niveles por debajo del menú que se recorre
+	if err := diner.RemoveItem("Hotdog"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := it.Next(); !errors.Is(err, iterator.ErrConcurrentModification) {
+		t.Fatalf("err = %v, want ErrConcurrentModification", err)
+	}
+}
+
+func TestConcurrentEditsAndIteration(t *testing.T) {
+	pancakes, diner := NewPancakeHouseMenu(), NewDinerMenu()
+	all := NewCompositeMenu("All", "")
+	all.AddSubMenu(pancakes)
+	all.AddSubMenu(diner)
+
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 25 {
+				pancakes.AddMenuItem(models.NewMenuItem(fmt.Sprintf("Special %d-%d", i, j), "", false, money.USD(1)))
+				if diner.RemoveItem("Pasta") == nil {
+					diner.AddItem("Pasta", "", true, money.USD(3.89))
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 25 {
+				for item := range all.All() {
+					_ = item.Name
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(names(all)); got != 4+100+6 {
+		t.Fatalf("menus have %d items after the edits, want 110", got)
+	}
+}
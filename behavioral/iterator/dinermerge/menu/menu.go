@@ -3,8 +3,19 @@ package menu
 import (
 	"designpatterns/behavioral/iterator/dinermerge/iterator"
 	"designpatterns/behavioral/iterator/dinermerge/models"
+	"iter"
 )
 
 type Menu interface {
 	CreateIterator() iterator.Iterator[*models.MenuItem]
+	All() iter.Seq[*models.MenuItem]
+	Indexed() iter.Seq2[int, *models.MenuItem]
+}
+
+func all(m Menu) iter.Seq[*models.MenuItem] {
+	return iterator.SeqOf(m.CreateIterator)
+}
+
+func indexed(m Menu) iter.Seq2[int, *models.MenuItem] {
+	return iterator.Enumerate(m.All())
 }
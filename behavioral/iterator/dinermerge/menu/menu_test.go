@@ -0,0 +1,56 @@
+package menu
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"slices"
+	"testing"
+)
+
+func TestAllCanBeRangedTwice(t *testing.T) {
+	composite := NewCompositeMenu("All", "")
+	composite.AddSubMenu(NewPancakeHouseMenu())
+	composite.AddItem(models.NewMenuItem("Coffee", "", true, money.USD(1)))
+
+	menus := map[string]Menu{
+		"pancake":   NewPancakeHouseMenu(),
+		"diner":     NewDinerMenu(),
+		"composite": composite,
+	}
+	for name, m := range menus {
+		t.Run(name, func(t *testing.T) {
+			all := m.All()
+			first := len(slices.Collect(all))
+			second := len(slices.Collect(all))
+			if first == 0 || first != second {
+				t.Fatalf("first range %d items, second %d", first, second)
+			}
+
+			indexed := m.Indexed()
+			for range 2 {
+				count := 0
+				for i := range indexed {
+					if i != count {
+						t.Fatalf("index %d, want %d", i, count)
+					}
+					count++
+				}
+				if count != first {
+					t.Fatalf("Indexed yielded %d items, want %d", count, first)
+				}
+			}
+		})
+	}
+}
+
+func TestAllSeesChangesMadeAfterItWasCreated(t *testing.T) {
+	p := NewPancakeHouseMenu()
+	all := p.All()
+	before := len(slices.Collect(all))
+	if err := p.AddItem("Crepes", "", true, money.USD(4)); err != nil {
+		t.Fatalf("AddItem: %v", err)
+	}
+	if after := len(slices.Collect(all)); after != before+1 {
+		t.Fatalf("got %d items, want %d", after, before+1)
+	}
+}
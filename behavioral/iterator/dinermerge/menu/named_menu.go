@@ -0,0 +1,7 @@
+package menu
+
+type NamedMenu interface {
+	Menu
+	GetName() string
+	GetDescription() string
+}
@@ -3,28 +3,118 @@ package menu
 import (
 	"designpatterns/behavioral/iterator/dinermerge/iterator"
 	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"fmt"
+	"iter"
+	"slices"
 )
 
 type PancakeHouseMenu struct {
+	guard
 	menuItems []*models.MenuItem
 }
 
 func NewPancakeHouseMenu() *PancakeHouseMenu {
 	menuItems := []*models.MenuItem{
-		models.NewMenuItem("K&B's Pancake Breakfast", "Pancakes with scrambled eggs, and toast", true, 2.99),
-		models.NewMenuItem("Regular Pancake Breakfast", "Pancakes with fried eggs, sausage", false, 2.99),
-		models.NewMenuItem("Blueberry Pancakes", "Pancakes made with fresh blueberries", true, 3.49),
-		models.NewMenuItem("Waffles", "Waffles with your choice of blueberries or strawberries", true, 3.59),
+		models.NewMenuItem("K&B's Pancake Breakfast", "Pancakes with scrambled eggs, and toast", true, money.USD(2.99)).
+			WithTags(models.TagNutFree).
+			WithAllergens(models.AllergenGluten, models.AllergenEggs, models.AllergenDairy),
+		models.NewMenuItem("Regular Pancake Breakfast", "Pancakes with fried eggs, sausage", false, money.USD(2.99)).
+			WithTags(models.TagNutFree).
+			WithAllergens(models.AllergenGluten, models.AllergenEggs, models.AllergenDairy),
+		models.NewMenuItem("Blueberry Pancakes", "Pancakes made with fresh blueberries", true, money.USD(3.49)).
+			WithTags(models.TagNutFree).
+			WithAllergens(models.AllergenGluten, models.AllergenEggs, models.AllergenDairy),
+		models.NewMenuItem("Waffles", "Waffles with your choice of blueberries or strawberries", true, money.USD(3.59)).
+			WithAllergens(models.AllergenGluten, models.AllergenEggs, models.AllergenDairy, models.AllergenNuts),
 	}
 
 	return &PancakeHouseMenu{menuItems: menuItems}
 }
 
-func (p *PancakeHouseMenu) AddItem(name, description string, vegetarian bool, price float64) {
-	item := models.NewMenuItem(name, description, vegetarian, price)
+func (p *PancakeHouseMenu) AddItem(name, description string, vegetarian bool, price money.Money) error {
+	return p.AddMenuItem(models.NewMenuItem(name, description, vegetarian, price))
+}
+
+// AddMenuItem no tiene límite de capacidad, pero igual que DinerMenu
+// rechaza nombres repetidos.
+func (p *PancakeHouseMenu) AddMenuItem(item *models.MenuItem) error {
+	if item == nil {
+		return ErrNilItem
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.indexOf(item.Name) >= 0 {
+		return fmt.Errorf("%w: %s", ErrDuplicateItem, item.Name)
+	}
 	p.menuItems = append(p.menuItems, item)
+	p.modified()
+	return nil
+}
+
+func (p *PancakeHouseMenu) RemoveItem(name string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	index := p.indexOf(name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, name)
+	}
+	p.menuItems = slices.Delete(p.menuItems, index, index+1)
+	p.modified()
+	return nil
+}
+
+func (p *PancakeHouseMenu) UpdateItem(name string, updated *models.MenuItem) error {
+	if updated == nil {
+		return ErrNilItem
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	index := p.indexOf(name)
+	if index < 0 {
+		return fmt.Errorf("%w: %s", ErrItemNotFound, name)
+	}
+	if other := p.indexOf(updated.Name); other >= 0 && other != index {
+		return fmt.Errorf("%w: %s", ErrDuplicateItem, updated.Name)
+	}
+	p.menuItems[index] = updated
+	p.modified()
+	return nil
+}
+
+func (p *PancakeHouseMenu) Len() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.menuItems)
 }
 
+// CreateIterator recorre una copia de los ítems, así un AddItem
+// concurrente no afecta a quien está leyendo el menú.
 func (p *PancakeHouseMenu) CreateIterator() iterator.Iterator[*models.MenuItem] {
-	return iterator.NewPancakeHouseMenuIterator(p.menuItems)
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.iterator(iterator.NewPancakeHouseMenuIterator(snapshot(p.menuItems)), p.ModCount)
+}
+
+func (p *PancakeHouseMenu) CreateCursor() iterator.Cursor[*models.MenuItem] {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return iterator.NewSliceCursor(snapshot(p.menuItems), func(item *models.MenuItem) error {
+		return p.RemoveItem(item.Name)
+	})
+}
+
+// indexOf se llama con el lock tomado.
+func (p *PancakeHouseMenu) indexOf(name string) int {
+	return slices.IndexFunc(p.menuItems, func(item *models.MenuItem) bool {
+		return item.Name == name
+	})
+}
+
+func (p *PancakeHouseMenu) All() iter.Seq[*models.MenuItem] {
+	return all(p)
+}
+
+func (p *PancakeHouseMenu) Indexed() iter.Seq2[int, *models.MenuItem] {
+	return indexed(p)
 }
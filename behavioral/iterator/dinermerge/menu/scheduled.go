@@ -0,0 +1,14 @@
+package menu
+
+import "time"
+
+// Scheduled lo implementan los menús que solo se sirven en ciertos
+// horarios; los que no lo implementan están siempre disponibles.
+type Scheduled interface {
+	IsAvailableAt(t time.Time) bool
+}
+
+func IsAvailableAt(m Menu, t time.Time) bool {
+	scheduled, ok := m.(Scheduled)
+	return !ok || scheduled.IsAvailableAt(t)
+}
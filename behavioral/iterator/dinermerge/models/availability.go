@@ -0,0 +1,184 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeOfDay son los minutos desde la medianoche.
+type TimeOfDay int
+
+func At(hour, minute int) TimeOfDay {
+	return TimeOfDay(hour*60 + minute)
+}
+
+func (t TimeOfDay) String() string {
+	return fmt.Sprintf("%02d:%02d", int(t)/60, int(t)%60)
+}
+
+var (
+	Weekdays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+	Weekends = []time.Weekday{time.Saturday, time.Sunday}
+)
+
+// Window es un horario: de Start (incluido) a End (excluido), los días
+// indicados. Sin días vale todos los días; con Start == End vale el día
+// entero; si End < Start cruza la medianoche.
+type Window struct {
+	Days  []time.Weekday
+	Start TimeOfDay
+	End   TimeOfDay
+}
+
+func Daily(start, end TimeOfDay) Window {
+	return Window{Start: start, End: end}
+}
+
+func On(days []time.Weekday, start, end TimeOfDay) Window {
+	return Window{Days: days, Start: start, End: end}
+}
+
+func WeekendsOnly() Window {
+	return Window{Days: Weekends}
+}
+
+var (
+	BreakfastHours = Daily(At(7, 0), At(11, 0))
+	LunchHours     = Daily(At(11, 0), At(15, 0))
+	DinnerHours    = Daily(At(17, 0), At(22, 0))
+)
+
+func (w Window) Contains(t time.Time) bool {
+	minute := At(t.Hour(), t.Minute())
+	day := t.Weekday()
+	if w.Start > w.End && minute < w.End {
+		// Pasada la medianoche, el horario pertenece al día anterior.
+		day = (day + 6) % 7
+	}
+	if len(w.Days) > 0 && !slices.Contains(w.Days, day) {
+		return false
+	}
+
+	switch {
+	case w.Start == w.End:
+		return true
+	case w.Start < w.End:
+		return minute >= w.Start && minute < w.End
+	default:
+		return minute >= w.Start || minute < w.End
+	}
+}
+
+// Availability agrupa horarios; sin horarios está siempre disponible.
+type Availability []Window
+
+func (a Availability) IsAvailableAt(t time.Time) bool {
+	if len(a) == 0 {
+		return true
+	}
+	for _, window := range a {
+		if window.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+var dayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// String usa el mismo formato que ParseWindow: "sat,sun 10:00-14:00".
+func (w Window) String() string {
+	var parts []string
+	if len(w.Days) > 0 {
+		days := make([]string, len(w.Days))
+		for i, day := range w.Days {
+			days[i] = dayNames[day]
+		}
+		parts = append(parts, strings.Join(days, ","))
+	}
+	switch {
+	case w.Start != w.End:
+		parts = append(parts, w.Start.String()+"-"+w.End.String())
+	case len(w.Days) == 0:
+		parts = append(parts, "daily")
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseWindow lee horarios como "07:00-11:00", "weekends",
+// "mon-fri 11:00-15:00" o "sat,sun 10:00-14:00".
+func ParseWindow(text string) (Window, error) {
+	var window Window
+	hasDays, hasHours := false, false
+	for _, field := range strings.Fields(strings.ToLower(text)) {
+		if strings.Contains(field, ":") {
+			start, end, ok := strings.Cut(field, "-")
+			if !ok {
+				return Window{}, fmt.Errorf("invalid time range %q", field)
+			}
+			var err error
+			if window.Start, err = parseTimeOfDay(start); err != nil {
+				return Window{}, err
+			}
+			if window.End, err = parseTimeOfDay(end); err != nil {
+				return Window{}, err
+			}
+			hasHours = true
+			continue
+		}
+		days, err := parseDays(field)
+		if err != nil {
+			return Window{}, err
+		}
+		window.Days = append(window.Days, days...)
+		hasDays = true
+	}
+	if !hasDays && !hasHours {
+		return Window{}, fmt.Errorf("invalid window %q: expected days or a time range", text)
+	}
+	return window, nil
+}
+
+func parseTimeOfDay(text string) (TimeOfDay, error) {
+	hour, minute, ok := strings.Cut(text, ":")
+	h, hourErr := strconv.Atoi(hour)
+	m, minuteErr := strconv.Atoi(minute)
+	if !ok || hourErr != nil || minuteErr != nil || h < 0 || h > 24 || m < 0 || m > 59 || (h == 24 && m > 0) {
+		return 0, fmt.Errorf("invalid time %q", text)
+	}
+	return At(h, m) % At(24, 0), nil
+}
+
+func parseDays(text string) ([]time.Weekday, error) {
+	switch text {
+	case "daily":
+		return []time.Weekday{}, nil
+	case "weekdays":
+		return Weekdays, nil
+	case "weekends":
+		return Weekends, nil
+	}
+
+	var days []time.Weekday
+	for _, part := range strings.Split(text, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first := slices.Index(dayNames, from)
+		last := first
+		if isRange {
+			last = slices.Index(dayNames, to)
+		}
+		if first < 0 || last < 0 {
+			return nil, fmt.Errorf("invalid day %q", part)
+		}
+		for day := first; ; day = (day + 1) % 7 {
+			days = append(days, time.Weekday(day))
+			if day == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
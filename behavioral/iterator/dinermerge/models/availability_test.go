@@ -0,0 +1,81 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// El 3 de junio de 2024 fue lunes.
+func at(day, hour, minute int) time.Time {
+	return time.Date(2024, time.June, day, hour, minute, 0, 0, time.UTC)
+}
+
+func TestWindowContains(t *testing.T) {
+	lateNight := On([]time.Weekday{time.Friday, time.Saturday}, At(22, 0), At(2, 0))
+	tests := []struct {
+		name   string
+		window Window
+		t      time.Time
+		want   bool
+	}{
+		{"start included", BreakfastHours, at(3, 7, 0), true},
+		{"end excluded", BreakfastHours, at(3, 11, 0), false},
+		{"before start", BreakfastHours, at(3, 6, 59), false},
+		{"weekend all day", WeekendsOnly(), at(8, 23, 59), true},
+		{"weekend on a weekday", WeekendsOnly(), at(7, 12, 0), false},
+		{"friday night", lateNight, at(7, 23, 0), true},
+		{"after midnight belongs to friday", lateNight, at(8, 1, 30), true},
+		{"after midnight belongs to sunday", lateNight, at(10, 1, 30), false},
+		{"thursday night", lateNight, at(6, 23, 0), false},
+	}
+	for _, tt := range tests {
+		if got := tt.window.Contains(tt.t); got != tt.want {
+			t.Errorf("%s: Contains(%s) = %v, want %v", tt.name, tt.t.Format("Mon 15:04"), got, tt.want)
+		}
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	if !(Availability{}).IsAvailableAt(at(3, 3, 0)) {
+		t.Error("empty availability should always be available")
+	}
+	meals := Availability{BreakfastHours, DinnerHours}
+	if !meals.IsAvailableAt(at(3, 18, 0)) || meals.IsAvailableAt(at(3, 13, 0)) {
+		t.Error("meals should cover breakfast and dinner only")
+	}
+}
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"07:00-11:00", "07:00-11:00"},
+		{"weekends", "sat,sun"},
+		{"Mon-Fri 11:00-15:00", "mon,tue,wed,thu,fri 11:00-15:00"},
+		{"sat,sun 10:00-14:00", "sat,sun 10:00-14:00"},
+		{"fri-mon", "fri,sat,sun,mon"},
+		{"22:00-24:00", "22:00-00:00"},
+		{"daily", "daily"},
+	}
+	for _, tt := range tests {
+		window, err := ParseWindow(tt.text)
+		if err != nil {
+			t.Errorf("ParseWindow(%q): %v", tt.text, err)
+			continue
+		}
+		if got := window.String(); got != tt.want {
+			t.Errorf("ParseWindow(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+		again, err := ParseWindow(window.String())
+		if err != nil || again.String() != window.String() {
+			t.Errorf("round trip of %q = %q, %v", window, again, err)
+		}
+	}
+
+	for _, invalid := range []string{"", "07:00", "25:00-26:00", "07:60-08:00", "24:30-01:00", "someday", "mon-xyz"} {
+		if _, err := ParseWindow(invalid); err == nil {
+			t.Errorf("ParseWindow(%q) should fail", invalid)
+		}
+	}
+}
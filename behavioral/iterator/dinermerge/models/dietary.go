@@ -0,0 +1,45 @@
+package models
+
+type DietaryTag string
+
+const (
+	TagVegetarian DietaryTag = "vegetarian"
+	TagVegan      DietaryTag = "vegan"
+	TagGlutenFree DietaryTag = "gluten-free"
+	TagNutFree    DietaryTag = "nut-free"
+	TagSpicy      DietaryTag = "spicy"
+)
+
+type Allergen string
+
+const (
+	AllergenGluten    Allergen = "gluten"
+	AllergenDairy     Allergen = "dairy"
+	AllergenEggs      Allergen = "eggs"
+	AllergenNuts      Allergen = "nuts"
+	AllergenPeanuts   Allergen = "peanuts"
+	AllergenSoy       Allergen = "soy"
+	AllergenFish      Allergen = "fish"
+	AllergenShellfish Allergen = "shellfish"
+)
+
+// DietaryQuery describe lo que pide un cliente: etiquetas que el plato
+// debe tener y alérgenos que no puede contener.
+type DietaryQuery struct {
+	Require []DietaryTag
+	Exclude []Allergen
+}
+
+func (q DietaryQuery) Matches(item *MenuItem) bool {
+	for _, tag := range q.Require {
+		if !item.HasTag(tag) {
+			return false
+		}
+	}
+	for _, allergen := range q.Exclude {
+		if item.HasAllergen(allergen) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,13 +1,22 @@
 package models
 
+import (
+	"designpatterns/internal/money"
+	"slices"
+	"time"
+)
+
 type MenuItem struct {
 	Name        string
 	Description string
 	Vegetarian  bool
-	Price       float64
+	Price       money.Money
+	Tags        []DietaryTag
+	Allergens   []Allergen
+	Available   Availability
 }
 
-func NewMenuItem(name, description string, vegetarian bool, price float64) *MenuItem {
+func NewMenuItem(name, description string, vegetarian bool, price money.Money) *MenuItem {
 	return &MenuItem{
 		Name:        name,
 		Description: description,
@@ -16,6 +25,44 @@ func NewMenuItem(name, description string, vegetarian bool, price float64) *Menu
 	}
 }
 
+func (m *MenuItem) WithTags(tags ...DietaryTag) *MenuItem {
+	for _, tag := range tags {
+		if !slices.Contains(m.Tags, tag) {
+			m.Tags = append(m.Tags, tag)
+		}
+	}
+	return m
+}
+
+func (m *MenuItem) WithAllergens(allergens ...Allergen) *MenuItem {
+	for _, allergen := range allergens {
+		if !slices.Contains(m.Allergens, allergen) {
+			m.Allergens = append(m.Allergens, allergen)
+		}
+	}
+	return m
+}
+
+// WithAvailability limita el ítem a ciertos horarios, por ejemplo
+// especiales solo de fin de semana.
+func (m *MenuItem) WithAvailability(windows ...Window) *MenuItem {
+	m.Available = append(m.Available, windows...)
+	return m
+}
+
+func (m *MenuItem) IsAvailableAt(t time.Time) bool {
+	return m.Available.IsAvailableAt(t)
+}
+
+// Clone copia el ítem, incluidas sus etiquetas, alérgenos y horarios.
+func (m *MenuItem) Clone() *MenuItem {
+	clone := *m
+	clone.Tags = slices.Clone(m.Tags)
+	clone.Allergens = slices.Clone(m.Allergens)
+	clone.Available = slices.Clone(m.Available)
+	return &clone
+}
+
 func (m *MenuItem) GetName() string {
 	return m.Name
 }
@@ -25,9 +72,22 @@ func (m *MenuItem) GetDescription() string {
 }
 
 func (m *MenuItem) IsVegetarian() bool {
-	return m.Vegetarian
+	return m.Vegetarian || slices.Contains(m.Tags, TagVegetarian) || slices.Contains(m.Tags, TagVegan)
+}
+
+// HasTag trata "vegetarian" de forma especial porque también se deduce
+// del campo Vegetarian y de la etiqueta "vegan".
+func (m *MenuItem) HasTag(tag DietaryTag) bool {
+	if tag == TagVegetarian {
+		return m.IsVegetarian()
+	}
+	return slices.Contains(m.Tags, tag)
+}
+
+func (m *MenuItem) HasAllergen(allergen Allergen) bool {
+	return slices.Contains(m.Allergens, allergen)
 }
 
-func (m *MenuItem) GetPrice() float64 {
+func (m *MenuItem) GetPrice() money.Money {
 	return m.Price
 }
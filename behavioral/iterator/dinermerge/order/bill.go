@@ -0,0 +1,67 @@
+package order
+
+import (
+	"designpatterns/internal/money"
+	"fmt"
+	"strings"
+)
+
+type Bill struct {
+	Table    int
+	Lines    []Line
+	Subtotal money.Money
+	TaxRate  float64
+	Tax      money.Money
+	TipRate  float64
+	Tip      money.Money
+	Total    money.Money
+}
+
+// newBill calcula impuesto y propina sobre el subtotal, redondeando cada
+// uno al centavo antes de sumar.
+func newBill(t *table, taxRate, tipRate float64) (Bill, error) {
+	bill := Bill{
+		Table:    t.number,
+		Lines:    t.copyLines(),
+		Subtotal: t.subtotal(),
+		TaxRate:  taxRate,
+		TipRate:  tipRate,
+	}
+	var err error
+	if bill.Tax, err = bill.Subtotal.MulRate(taxRate, money.HalfUp); err != nil {
+		return Bill{}, fmt.Errorf("tax: %w", err)
+	}
+	if bill.Tip, err = bill.Subtotal.MulRate(tipRate, money.HalfUp); err != nil {
+		return Bill{}, fmt.Errorf("tip: %w", err)
+	}
+	bill.Total = bill.Subtotal.Add(bill.Tax).Add(bill.Tip)
+	return bill, nil
+}
+
+// Split divide el total en partes iguales; los centavos que sobran van a
+// los primeros comensales.
+func (b Bill) Split(diners int) ([]money.Money, error) {
+	return b.Total.Split(diners)
+}
+
+// SplitByShares divide el total en proporción a lo que consumió cada uno.
+func (b Bill) SplitByShares(shares ...int) ([]money.Money, error) {
+	return b.Total.Allocate(shares...)
+}
+
+func (b Bill) String() string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "TABLE %d\n", b.Table)
+	for _, line := range b.Lines {
+		description := fmt.Sprintf("%dx %s", line.Quantity, line.Item.Name)
+		if line.Notes != "" {
+			description += " (" + line.Notes + ")"
+		}
+		fmt.Fprintf(&s, "  %-44s %10s\n", description, line.Total())
+	}
+	fmt.Fprintf(&s, "  %-44s %10s\n", "Subtotal", b.Subtotal)
+	fmt.Fprintf(&s, "  %-44s %10s\n", fmt.Sprintf("Tax (%g%%)", b.TaxRate*100), b.Tax)
+	fmt.Fprintf(&s, "  %-44s %10s\n", fmt.Sprintf("Tip (%g%%)", b.TipRate*100), b.Tip)
+	fmt.Fprintf(&s, "  %-44s %10s", "Total", b.Total)
+	return s.String()
+}
@@ -0,0 +1,13 @@
+package order
+
+import "errors"
+
+var (
+	ErrTableAlreadyOpen = errors.New("table is already open")
+	ErrTableNotOpen     = errors.New("table is not open")
+	ErrInvalidQuantity  = errors.New("quantity must be greater than zero")
+	ErrInvalidGuests    = errors.New("guests must be greater than zero")
+	ErrLineNotFound     = errors.New("item is not in the order")
+	ErrEmptyOrder       = errors.New("order has no items")
+	ErrInvalidRate      = errors.New("rate must be a non-negative number")
+)
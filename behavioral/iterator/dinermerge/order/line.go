@@ -0,0 +1,16 @@
+package order
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+)
+
+type Line struct {
+	Item     *models.MenuItem
+	Quantity int
+	Notes    string
+}
+
+func (l Line) Total() money.Money {
+	return l.Item.GetPrice().Mul(int64(l.Quantity))
+}
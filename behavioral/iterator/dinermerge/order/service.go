@@ -0,0 +1,200 @@
+package order
+
+import (
+	"cmp"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/clock"
+	"designpatterns/internal/money"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ItemFinder es lo único que el servicio necesita de la mesera: buscar un
+// ítem por nombre recorriendo todos los menús.
+type ItemFinder interface {
+	FindItem(name string) (*models.MenuItem, error)
+}
+
+// ScheduledFinder lo implementan los buscadores que conocen los horarios
+// de los menús; con ellos el servicio rechaza, por ejemplo, pancakes a la
+// hora de la cena.
+type ScheduledFinder interface {
+	FindItemAt(name string, t time.Time) (*models.MenuItem, error)
+}
+
+type Service struct {
+	mu      sync.Mutex
+	finder  ItemFinder
+	taxRate float64
+	clock   clock.Clock
+	tables  map[int]*table
+	closed  []*table
+}
+
+// NewService devuelve ErrInvalidRate si la tasa de impuesto es negativa
+// o no es un número.
+func NewService(finder ItemFinder, taxRate float64) (*Service, error) {
+	if err := checkRate("tax", taxRate); err != nil {
+		return nil, err
+	}
+	return &Service{
+		finder:  finder,
+		taxRate: taxRate,
+		clock:   clock.NewSystemClock(),
+		tables:  make(map[int]*table),
+	}, nil
+}
+
+func (s *Service) SetClock(c clock.Clock) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock = c
+}
+
+func (s *Service) OpenTable(number, guests int) (TableView, error) {
+	if guests <= 0 {
+		return TableView{}, ErrInvalidGuests
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.tables[number]; ok {
+		return TableView{}, fmt.Errorf("%w: %d", ErrTableAlreadyOpen, number)
+	}
+	table := newTable(number, guests, s.clock.Now())
+	s.tables[number] = table
+	return table.view(), nil
+}
+
+// Table devuelve una foto de la mesa; los cambios posteriores no la
+// afectan.
+func (s *Service) Table(number int) (TableView, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, err := s.table(number)
+	if err != nil {
+		return TableView{}, err
+	}
+	return table.view(), nil
+}
+
+// AddItem devuelve una copia de la línea con la cantidad acumulada.
+func (s *Service) AddItem(number int, name string, quantity int, notes string) (Line, error) {
+	if quantity <= 0 {
+		return Line{}, ErrInvalidQuantity
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, err := s.table(number)
+	if err != nil {
+		return Line{}, err
+	}
+	item, err := s.findItem(name)
+	if err != nil {
+		return Line{}, err
+	}
+	if currency := item.GetPrice().Currency(); len(table.lines) > 0 && currency != table.currency() {
+		return Line{}, &money.CurrencyMismatchError{Expected: table.currency(), Actual: currency}
+	}
+	return *table.add(item, quantity, notes), nil
+}
+
+// RemoveItem quita quantity unidades del ítem; con quantity <= 0 quita la
+// línea completa.
+func (s *Service) RemoveItem(number int, name string, quantity int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, err := s.table(number)
+	if err != nil {
+		return err
+	}
+	if err := table.remove(name, quantity); err != nil {
+		return fmt.Errorf("%w: %s", err, name)
+	}
+	return nil
+}
+
+func (s *Service) Bill(number int, tipRate float64) (Bill, error) {
+	if err := checkRate("tip", tipRate); err != nil {
+		return Bill{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, err := s.table(number)
+	if err != nil {
+		return Bill{}, err
+	}
+	return newBill(table, s.taxRate, tipRate)
+}
+
+// CloseTable emite la cuenta final y libera la mesa para nuevos clientes.
+func (s *Service) CloseTable(number int, tipRate float64) (Bill, error) {
+	if err := checkRate("tip", tipRate); err != nil {
+		return Bill{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	table, err := s.table(number)
+	if err != nil {
+		return Bill{}, err
+	}
+	if len(table.lines) == 0 {
+		return Bill{}, fmt.Errorf("%w: table %d", ErrEmptyOrder, number)
+	}
+
+	bill, err := newBill(table, s.taxRate, tipRate)
+	if err != nil {
+		return Bill{}, err
+	}
+	table.status = Closed
+	table.closedAt = s.clock.Now()
+	delete(s.tables, number)
+	s.closed = append(s.closed, table)
+	return bill, nil
+}
+
+func (s *Service) OpenTables() []TableView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tables := make([]TableView, 0, len(s.tables))
+	for _, table := range s.tables {
+		tables = append(tables, table.view())
+	}
+	slices.SortFunc(tables, func(a, b TableView) int { return cmp.Compare(a.Number, b.Number) })
+	return tables
+}
+
+func (s *Service) ClosedTables() []TableView {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	tables := make([]TableView, len(s.closed))
+	for i, table := range s.closed {
+		tables[i] = table.view()
+	}
+	return tables
+}
+
+func (s *Service) findItem(name string) (*models.MenuItem, error) {
+	if scheduled, ok := s.finder.(ScheduledFinder); ok {
+		return scheduled.FindItemAt(name, s.clock.Now())
+	}
+	return s.finder.FindItem(name)
+}
+
+func (s *Service) table(number int) (*table, error) {
+	table, ok := s.tables[number]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrTableNotOpen, number)
+	}
+	return table, nil
+}
+
+func checkRate(name string, rate float64) error {
+	if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return fmt.Errorf("%w: %s rate %v", ErrInvalidRate, name, rate)
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package order
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/behavioral/iterator/dinermerge/waitress"
+	"designpatterns/internal/money"
+	"errors"
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestTableIsASnapshot(t *testing.T) {
+	s, err := NewService(waitress.NewWaitress(menu.NewDinerMenu()), 0.0825)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.OpenTable(1, 2); err != nil {
+		t.Fatal(err)
+	}
+	line, err := s.AddItem(1, "BLT", 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	before, _ := s.Table(1)
+
+	if _, err := s.AddItem(1, "blt", 2, ""); err != nil {
+		t.Fatal(err)
+	}
+	if line.Quantity != 1 || before.Lines[0].Quantity != 1 || before.Subtotal.MinorUnits() != 299 {
+		t.Fatalf("earlier copies changed: line %d, view %d, subtotal %v", line.Quantity, before.Lines[0].Quantity, before.Subtotal)
+	}
+
+	after, _ := s.Table(1)
+	if after.Lines[0].Quantity != 3 || after.Subtotal.MinorUnits() != 897 {
+		t.Fatalf("after = %+v", after)
+	}
+	after.Lines[0].Quantity = 100
+	if current, _ := s.Table(1); current.Lines[0].Quantity != 3 {
+		t.Fatal("editing a view changed the table")
+	}
+}
+
+func TestAddItemRejectsMixedCurrencies(t *testing.T) {
+	crepes := menu.NewCompositeMenu("Crêperie", "")
+	crepes.AddItem(models.NewMenuItem("Crepe", "", true, money.EUR(4.50)))
+	s, err := NewService(waitress.NewWaitress(menu.NewDinerMenu(), crepes), 0.0825)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.OpenTable(1, 2)
+	s.AddItem(1, "Pasta", 1, "")
+
+	var mismatch *money.CurrencyMismatchError
+	if _, err := s.AddItem(1, "Crepe", 1, ""); !errors.As(err, &mismatch) {
+		t.Fatalf("err = %v, want CurrencyMismatchError", err)
+	}
+}
+
+func TestCloseTable(t *testing.T) {
+	s, err := NewService(waitress.NewWaitress(menu.NewDinerMenu()), 0.0825)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.OpenTable(4, 3)
+	if _, err := s.CloseTable(4, 0.15); !errors.Is(err, ErrEmptyOrder) {
+		t.Fatalf("err = %v, want ErrEmptyOrder", err)
+	}
+	s.AddItem(4, "BLT", 2, "no tomato")
+	s.AddItem(4, "Pasta", 1, "")
+
+	bill, err := s.CloseTable(4, 0.15)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 9.87 + 0.81 de impuesto + 1.48 de propina.
+	if bill.Subtotal.MinorUnits() != 987 || bill.Tax.MinorUnits() != 81 || bill.Tip.MinorUnits() != 148 || bill.Total.MinorUnits() != 1216 {
+		t.Fatalf("bill = %+v", bill)
+	}
+	if _, err := s.Table(4); !errors.Is(err, ErrTableNotOpen) {
+		t.Fatalf("err = %v, want ErrTableNotOpen", err)
+	}
+	closed := s.ClosedTables()
+	if len(closed) != 1 || closed[0].Status != Closed || len(closed[0].Lines) != 2 {
+		t.Fatalf("closed = %+v", closed)
+	}
+}
+
+func TestRemoveItem(t *testing.T) {
+	s, err := NewService(waitress.NewWaitress(menu.NewDinerMenu()), 0.0825)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.OpenTable(1, 1)
+	s.AddItem(1, "BLT", 3, "")
+	if err := s.RemoveItem(1, "blt", 2); err != nil {
+		t.Fatal(err)
+	}
+	if view, _ := s.Table(1); view.Lines[0].Quantity != 1 {
+		t.Fatalf("quantity = %d, want 1", view.Lines[0].Quantity)
+	}
+	if err := s.RemoveItem(1, "BLT", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.RemoveItem(1, "BLT", 0); !errors.Is(err, ErrLineNotFound) {
+		t.Fatalf("err = %v, want ErrLineNotFound", err)
+	}
+}
+
+// Con -race este test detecta si algún método entrega estado compartido.
+func TestConcurrentReadsAndWrites(t *testing.T) {
+	s, err := NewService(waitress.NewWaitress(menu.NewDinerMenu()), 0.0825)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.OpenTable(1, 2)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				s.AddItem(1, "BLT", 1, "")
+				s.RemoveItem(1, "BLT", 1)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 50 {
+				view, _ := s.Table(1)
+				for _, line := range view.Lines {
+					_ = line.Quantity
+				}
+				for _, open := range s.OpenTables() {
+					_ = open.Subtotal
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRatesMustBeNonNegativeNumbers(t *testing.T) {
+	diner := waitress.NewWaitress(menu.NewDinerMenu())
+	for _, rate := range []float64{-0.01, math.NaN(), math.Inf(1)} {
+		if _, err := NewService(diner, rate); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("NewService(tax %v) err = %v, want ErrInvalidRate", rate, err)
+		}
+	}
+
+	s, err := NewService(diner, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.OpenTable(1, 2)
+	s.AddItem(1, "BLT", 1, "")
+	for _, rate := range []float64{-0.15, math.NaN()} {
+		if _, err := s.Bill(1, rate); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("Bill(tip %v) err = %v, want ErrInvalidRate", rate, err)
+		}
+		if _, err := s.CloseTable(1, rate); !errors.Is(err, ErrInvalidRate) {
+			t.Errorf("CloseTable(tip %v) err = %v, want ErrInvalidRate", rate, err)
+		}
+	}
+	if _, err := s.Table(1); err != nil {
+		t.Fatalf("a rejected tip must leave the table open: %v", err)
+	}
+	if bill, err := s.CloseTable(1, 0); err != nil || !bill.Total.Equal(money.USD(2.99)) {
+		t.Fatalf("CloseTable without tax or tip = %v, %v", bill.Total, err)
+	}
+}
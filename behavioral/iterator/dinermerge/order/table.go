@@ -0,0 +1,123 @@
+package order
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"strings"
+	"time"
+)
+
+type Status int
+
+const (
+	Open Status = iota
+	Closed
+)
+
+func (s Status) String() string {
+	if s == Closed {
+		return "closed"
+	}
+	return "open"
+}
+
+// TableView es una foto de la mesa: las líneas son copias, así quien la
+// lee no compite con el servicio cuando éste agrega o quita ítems.
+type TableView struct {
+	Number   int
+	Guests   int
+	OpenedAt time.Time
+	ClosedAt time.Time
+	Status   Status
+	Lines    []Line
+	Subtotal money.Money
+}
+
+func (v TableView) CreateIterator() iterator.Iterator[Line] {
+	return iterator.NewSliceIterator(v.Lines)
+}
+
+// table es el estado mutable; sólo el servicio lo toca, con su lock tomado.
+type table struct {
+	number   int
+	guests   int
+	openedAt time.Time
+	closedAt time.Time
+	status   Status
+	lines    []*Line
+}
+
+func newTable(number, guests int, openedAt time.Time) *table {
+	return &table{
+		number:   number,
+		guests:   guests,
+		openedAt: openedAt,
+		status:   Open,
+	}
+}
+
+func (t *table) view() TableView {
+	return TableView{
+		Number:   t.number,
+		Guests:   t.guests,
+		OpenedAt: t.openedAt,
+		ClosedAt: t.closedAt,
+		Status:   t.status,
+		Lines:    t.copyLines(),
+		Subtotal: t.subtotal(),
+	}
+}
+
+func (t *table) copyLines() []Line {
+	lines := make([]Line, len(t.lines))
+	for i, line := range t.lines {
+		lines[i] = *line
+	}
+	return lines
+}
+
+// currency es la moneda de la primera línea; sin líneas se asume USD.
+func (t *table) currency() money.Currency {
+	if len(t.lines) == 0 {
+		return money.CurrencyUSD
+	}
+	return t.lines[0].Item.GetPrice().Currency()
+}
+
+func (t *table) subtotal() money.Money {
+	subtotal := money.Zero(t.currency())
+	for _, line := range t.lines {
+		subtotal = subtotal.Add(line.Total())
+	}
+	return subtotal
+}
+
+// add suma la cantidad a una línea existente si el ítem y las notas
+// coinciden; si no, agrega una línea nueva.
+func (t *table) add(item *models.MenuItem, quantity int, notes string) *Line {
+	for _, line := range t.lines {
+		if line.Item == item && line.Notes == notes {
+			line.Quantity += quantity
+			return line
+		}
+	}
+	line := &Line{Item: item, Quantity: quantity, Notes: notes}
+	t.lines = append(t.lines, line)
+	return line
+}
+
+func (t *table) remove(name string, quantity int) error {
+	for i, line := range t.lines {
+		if !strings.EqualFold(line.Item.Name, name) {
+			continue
+		}
+		if quantity <= 0 || quantity >= line.Quantity {
+			t.lines = append(t.lines[:i], t.lines[i+1:]...)
+		} else {
+			line.Quantity -= quantity
+		}
+		return nil
+	}
+	return ErrLineNotFound
+}
@@ -0,0 +1,143 @@
+package render
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"time"
+)
+
+// Document es la vista de los menús que reciben los renderers: secciones
+// anidadas con los precios ya formateados.
+type Document struct {
+	Title    string    `json:"title"`
+	Sections []Section `json:"sections"`
+}
+
+type Section struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Items       []Item    `json:"items,omitempty"`
+	Sections    []Section `json:"sections,omitempty"`
+}
+
+type Item struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Price       string   `json:"price"`
+	Amount      string   `json:"amount"`
+	Currency    string   `json:"currency"`
+	Vegetarian  bool     `json:"vegetarian"`
+	Tags        []string `json:"tags,omitempty"`
+	Allergens   []string `json:"allergens,omitempty"`
+}
+
+// Options controla cómo se arma el documento. Con At distinto de cero
+// solo se incluye lo disponible en ese momento.
+type Options struct {
+	Locale money.Locale
+	At     time.Time
+}
+
+func DefaultOptions() Options {
+	return Options{Locale: money.EnUS}
+}
+
+func NewDocument(title string, options Options, menus ...menu.Menu) (Document, error) {
+	doc := Document{Title: title}
+	for _, m := range menus {
+		if !options.includes(m) {
+			continue
+		}
+		section, err := newSection(m, options)
+		if err != nil {
+			return Document{}, err
+		}
+		doc.Sections = append(doc.Sections, section)
+	}
+	return doc, nil
+}
+
+// FromItems arma un documento de una sola sección a partir de cualquier
+// iterador, por ejemplo el resultado de un filtro.
+func FromItems(title string, options Options, items iterator.Iterator[*models.MenuItem]) (Document, error) {
+	section := Section{}
+	if err := section.addItems(items, options); err != nil {
+		return Document{}, err
+	}
+	return Document{Title: title, Sections: []Section{section}}, nil
+}
+
+func newSection(m menu.Menu, options Options) (Section, error) {
+	var section Section
+	if named, ok := m.(menu.NamedMenu); ok {
+		section.Name = named.GetName()
+		section.Description = named.GetDescription()
+	}
+
+	composite, ok := m.(*menu.CompositeMenu)
+	if !ok {
+		return section, section.addItems(m.CreateIterator(), options)
+	}
+
+	for _, entry := range composite.Entries() {
+		if entry.SubMenu == nil {
+			if options.At.IsZero() || entry.Item.IsAvailableAt(options.At) {
+				section.Items = append(section.Items, newItem(entry.Item, options.Locale))
+			}
+			continue
+		}
+		if !options.includes(entry.SubMenu) {
+			continue
+		}
+		sub, err := newSection(entry.SubMenu, options)
+		if err != nil {
+			return Section{}, err
+		}
+		// Los menús sin nombre (DinerMenu, PancakeHouseMenu) se muestran
+		// como parte de la sección que los contiene.
+		if sub.Name == "" {
+			section.Items = append(section.Items, sub.Items...)
+			section.Sections = append(section.Sections, sub.Sections...)
+			continue
+		}
+		section.Sections = append(section.Sections, sub)
+	}
+	return section, nil
+}
+
+func (s *Section) addItems(items iterator.Iterator[*models.MenuItem], options Options) error {
+	for items.HasNext() {
+		item, err := items.Next()
+		if err != nil {
+			return err
+		}
+		if options.At.IsZero() || item.IsAvailableAt(options.At) {
+			s.Items = append(s.Items, newItem(item, options.Locale))
+		}
+	}
+	return nil
+}
+
+func newItem(item *models.MenuItem, locale money.Locale) Item {
+	rendered := Item{
+		Name:        item.Name,
+		Description: item.Description,
+		Price:       item.Price.Format(locale),
+		Amount:      item.Price.Decimal(),
+		Currency:    item.Price.Currency().Code,
+		Vegetarian:  item.IsVegetarian(),
+	}
+	for _, tag := range item.Tags {
+		rendered.Tags = append(rendered.Tags, string(tag))
+	}
+	for _, allergen := range item.Allergens {
+		rendered.Allergens = append(rendered.Allergens, string(allergen))
+	}
+	return rendered
+}
+
+func (o Options) includes(m menu.Menu) bool {
+	return o.At.IsZero() || menu.IsAvailableAt(m, o.At)
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"html/template"
+	"io"
+)
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{range .Sections}}{{template "section" .}}{{end}}
+</body>
+</html>
+{{define "section"}}<section>
+{{if .Name}}<h2>{{.Name}}</h2>
+{{end}}{{if .Description}}<p class="description">{{.Description}}</p>
+{{end}}{{if .Items}}<ul>
+{{range .Items}}  <li class="item{{if .Vegetarian}} vegetarian{{end}}">
+    <span class="name">{{.Name}}</span>
+    <span class="price" data-amount="{{.Amount}}" data-currency="{{.Currency}}">{{.Price}}</span>
+    {{if .Description}}<p>{{.Description}}</p>{{end}}
+  </li>
+{{end}}</ul>
+{{end}}{{range .Sections}}{{template "section" .}}{{end}}</section>
+{{end}}`
+
+// HTMLRenderer usa html/template, que escapa nombres y descripciones.
+type HTMLRenderer struct {
+	template *template.Template
+}
+
+func NewHTMLRenderer() (*HTMLRenderer, error) {
+	tmpl, err := template.New("menu").Parse(htmlTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &HTMLRenderer{template: tmpl}, nil
+}
+
+func (h *HTMLRenderer) Render(w io.Writer, doc Document) error {
+	return h.template.Execute(w, doc)
+}
@@ -0,0 +1,20 @@
+package render
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type JSONRenderer struct {
+	Indent string
+}
+
+func NewJSONRenderer() *JSONRenderer {
+	return &JSONRenderer{Indent: "  "}
+}
+
+func (j *JSONRenderer) Render(w io.Writer, doc Document) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", j.Indent)
+	return encoder.Encode(doc)
+}
@@ -0,0 +1,56 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type MarkdownRenderer struct{}
+
+func NewMarkdownRenderer() *MarkdownRenderer {
+	return &MarkdownRenderer{}
+}
+
+func (m *MarkdownRenderer) Render(w io.Writer, doc Document) error {
+	var b strings.Builder
+	if doc.Title != "" {
+		fmt.Fprintf(&b, "# %s\n", escapeMarkdown(doc.Title))
+	}
+	for _, section := range doc.Sections {
+		m.section(&b, section, 2)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (m *MarkdownRenderer) section(b *strings.Builder, section Section, level int) {
+	if section.Name != "" {
+		fmt.Fprintf(b, "\n%s %s\n", strings.Repeat("#", min(level, 6)), escapeMarkdown(section.Name))
+		if section.Description != "" {
+			fmt.Fprintf(b, "\n_%s_\n", escapeMarkdown(section.Description))
+		}
+	}
+
+	if len(section.Items) > 0 {
+		b.WriteString("\n| Item | Description | Price |\n| --- | --- | ---: |\n")
+		for _, item := range section.Items {
+			name := escapeMarkdown(item.Name)
+			if item.Vegetarian {
+				name += " (V)"
+			}
+			fmt.Fprintf(b, "| %s | %s | %s |\n", name, escapeMarkdown(item.Description), escapeMarkdown(item.Price))
+		}
+	}
+	for _, sub := range section.Sections {
+		m.section(b, sub, level+1)
+	}
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "#", `\#`,
+)
+
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
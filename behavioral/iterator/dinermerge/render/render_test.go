@@ -0,0 +1,143 @@
+package render
+
+import (
+	"bytes"
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewDocument(t *testing.T) {
+	breakfast := menu.NewCompositeMenu("Breakfast", "Until 11").WithAvailability(models.BreakfastHours)
+	breakfast.AddSubMenu(menu.NewPancakeHouseMenu())
+	all := menu.NewCompositeMenu("All Menus", "")
+	all.AddSubMenu(breakfast)
+	all.AddSubMenu(menu.NewDinerMenu())
+	all.AddItem(models.NewMenuItem("Fish & Chips", "", false, money.EUR(8.5)).WithTags(models.TagSpicy))
+
+	doc, err := NewDocument("MENU", Options{Locale: money.EsES}, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := doc.Sections[0]
+	// Los menús sin nombre se funden con la sección que los contiene.
+	if len(root.Items) != 7 || len(root.Sections) != 1 || len(root.Sections[0].Items) != 4 {
+		t.Fatalf("root = %d items, %d sections", len(root.Items), len(root.Sections))
+	}
+	// El precio se formatea según el locale; Amount y Currency no.
+	if fish := root.Items[6]; fish.Price != "8,50 €" || fish.Amount != "8.50" || fish.Currency != "EUR" || strings.Join(fish.Tags, ",") != "spicy" {
+		t.Fatalf("item = %+v", fish)
+	}
+
+	evening := time.Date(2024, time.June, 3, 20, 0, 0, 0, time.UTC)
+	doc, err = NewDocument("MENU", Options{Locale: money.EnUS, At: evening}, all)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sections := doc.Sections[0].Sections; len(sections) != 0 {
+		t.Fatalf("breakfast should not be rendered at 20:00: %+v", sections)
+	}
+}
+
+// sample es la entrada de los renderers: nombres con caracteres que cada
+// formato debe escapar y una sección anidada.
+var sample = Document{
+	Title: "MENU",
+	Sections: []Section{{
+		Name: "Dinner",
+		Items: []Item{
+			{Name: "Fish & <Chips>", Description: "Beer | batter", Price: "€8.50", Amount: "8.50", Currency: "EUR"},
+			{Name: "Steamed Veggies and Brown Rice", Price: "$3.99", Amount: "3.99", Currency: "USD", Vegetarian: true},
+		},
+		Sections: []Section{{
+			Name:        "Desserts",
+			Description: "Made *in house*",
+			Items:       []Item{{Name: "Apple Pie", Price: "$1.59", Amount: "1.59", Currency: "USD", Vegetarian: true}},
+		}},
+	}},
+}
+
+func TestRenderers(t *testing.T) {
+	html, err := NewHTMLRenderer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		renderer Renderer
+		want     []string
+		reject   []string
+	}{
+		{
+			name:     "text",
+			renderer: &TextRenderer{Width: 40},
+			want: []string{
+				"MENU\n====\n\nDinner\n------\n",
+				"Fish & <Chips> ................... €8.50\n    Beer | batter\n",
+				// Si el nombre no entra, queda al menos un punto.
+				"Steamed Veggies and Brown Rice (V) . $3.99\n",
+				"\n  Desserts - Made *in house*\n  --------------------------\n  Apple Pie (V) .................. $1.59\n",
+			},
+		},
+		{
+			name:     "markdown",
+			renderer: NewMarkdownRenderer(),
+			want: []string{
+				"# MENU\n\n## Dinner\n",
+				`| Fish & <Chips> | Beer \| batter | €8.50 |`,
+				"\n### Desserts\n\n_Made \\*in house\\*_\n",
+			},
+		},
+		{
+			name:     "html",
+			renderer: html,
+			want:     []string{"Fish &amp; &lt;Chips&gt;", `data-amount="8.50" data-currency="EUR"`, `class="item vegetarian"`},
+			reject:   []string{"<Chips>"},
+		},
+	}
+	for _, tt := range tests {
+		var out bytes.Buffer
+		if err := tt.renderer.Render(&out, sample); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("%s output missing %q:\n%s", tt.name, want, out.String())
+			}
+		}
+		for _, reject := range tt.reject {
+			if strings.Contains(out.String(), reject) {
+				t.Errorf("%s output should not contain %q", tt.name, reject)
+			}
+		}
+	}
+}
+
+func TestJSONRendererRoundTrip(t *testing.T) {
+	var out bytes.Buffer
+	if err := NewJSONRenderer().Render(&out, sample); err != nil {
+		t.Fatal(err)
+	}
+	var doc Document
+	if err := json.Unmarshal(out.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Title != "MENU" || doc.Sections[0].Sections[0].Items[0].Name != "Apple Pie" {
+		t.Fatalf("doc = %+v", doc)
+	}
+}
+
+func TestForFormat(t *testing.T) {
+	for _, format := range []string{"text", "TXT", "md", "markdown", "html", "json"} {
+		if _, err := ForFormat(format); err != nil {
+			t.Errorf("ForFormat(%q): %v", format, err)
+		}
+	}
+	if _, err := ForFormat("pdf"); err == nil {
+		t.Error("ForFormat(pdf) should fail")
+	}
+}
@@ -0,0 +1,27 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Renderer interface {
+	Render(w io.Writer, doc Document) error
+}
+
+// ForFormat elige el renderer por nombre: text, markdown, html o json.
+func ForFormat(format string) (Renderer, error) {
+	switch strings.ToLower(format) {
+	case "text", "txt":
+		return NewTextRenderer(), nil
+	case "markdown", "md":
+		return NewMarkdownRenderer(), nil
+	case "html":
+		return NewHTMLRenderer()
+	case "json":
+		return NewJSONRenderer(), nil
+	default:
+		return nil, fmt.Errorf("unknown render format: %q", format)
+	}
+}
@@ -0,0 +1,67 @@
+package render
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+const defaultTextWidth = 72
+
+// TextRenderer alinea los precios a la derecha y deja la descripción en
+// una segunda línea, sangrada según la profundidad de la sección.
+type TextRenderer struct {
+	Width int
+}
+
+func NewTextRenderer() *TextRenderer {
+	return &TextRenderer{Width: defaultTextWidth}
+}
+
+func (t *TextRenderer) Render(w io.Writer, doc Document) error {
+	var b strings.Builder
+	if doc.Title != "" {
+		b.WriteString(doc.Title + "\n")
+		b.WriteString(strings.Repeat("=", utf8.RuneCountInString(doc.Title)) + "\n")
+	}
+	for _, section := range doc.Sections {
+		t.section(&b, section, 0)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (t *TextRenderer) section(b *strings.Builder, section Section, depth int) {
+	indent := strings.Repeat("  ", depth)
+	if section.Name != "" {
+		heading := section.Name
+		if section.Description != "" {
+			heading += " - " + section.Description
+		}
+		fmt.Fprintf(b, "\n%s%s\n", indent, heading)
+		fmt.Fprintf(b, "%s%s\n", indent, strings.Repeat("-", utf8.RuneCountInString(heading)))
+	}
+
+	for _, item := range section.Items {
+		name := item.Name
+		if item.Vegetarian {
+			name += " (V)"
+		}
+		dots := t.width() - len(indent) - utf8.RuneCountInString(name) - utf8.RuneCountInString(item.Price) - 2
+		fmt.Fprintf(b, "%s%s %s %s\n", indent, name, strings.Repeat(".", max(dots, 1)), item.Price)
+		if item.Description != "" {
+			fmt.Fprintf(b, "%s    %s\n", indent, item.Description)
+		}
+	}
+	for _, sub := range section.Sections {
+		t.section(b, sub, depth+1)
+	}
+}
+
+func (t *TextRenderer) width() int {
+	if t.Width <= 0 {
+		return defaultTextWidth
+	}
+	return t.Width
+}
@@ -0,0 +1,176 @@
+package search
+
+import (
+	"cmp"
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"slices"
+	"strings"
+)
+
+// Puntajes por tipo de coincidencia; el nombre pesa el doble que la
+// descripción.
+const (
+	exactScore       = 10.0
+	prefixScore      = 7.0
+	fuzzyScore       = 5.0
+	descriptionRatio = 0.5
+	phraseBonus      = 5.0
+)
+
+// Query filtra por etiquetas y por precio. MinPrice y MaxPrice en nil no
+// limitan; un MaxPrice de cero sí, así que busca solo lo gratuito.
+type Query struct {
+	Text     string
+	Tags     []models.DietaryTag
+	MinPrice *money.Money
+	MaxPrice *money.Money
+	Limit    int
+}
+
+type Result struct {
+	Item  *models.MenuItem
+	Menu  string
+	Score float64
+}
+
+type document struct {
+	item        *models.MenuItem
+	menu        string
+	name        string
+	nameTokens  []string
+	descTokens  []string
+	description string
+}
+
+type Index struct {
+	menus     []menu.Menu
+	documents []document
+}
+
+func NewIndex(menus ...menu.Menu) *Index {
+	index := &Index{menus: menus}
+	index.Refresh()
+	return index
+}
+
+// Refresh vuelve a indexar los menús; hace falta después de agregar o
+// quitar ítems.
+func (x *Index) Refresh() {
+	x.documents = x.documents[:0]
+	for _, m := range x.menus {
+		for section, item := range sections(m) {
+			x.documents = append(x.documents, document{
+				item:        item,
+				menu:        section,
+				name:        Normalize(item.Name),
+				nameTokens:  Tokenize(item.Name),
+				descTokens:  Tokenize(item.Description),
+				description: Normalize(item.Description),
+			})
+		}
+	}
+}
+
+func (x *Index) Len() int {
+	return len(x.documents)
+}
+
+// Find es un atajo para buscar solo por texto.
+func (x *Index) Find(text string) []Result {
+	return x.Search(Query{Text: text})
+}
+
+// Search devuelve los ítems que coinciden con todas las palabras de la
+// consulta y con los filtros, ordenados del más relevante al menos.
+// Sin texto se devuelven todos los que pasan los filtros.
+func (x *Index) Search(query Query) []Result {
+	terms := Tokenize(query.Text)
+	phrase := strings.Join(terms, " ")
+
+	var results []Result
+	for _, doc := range x.documents {
+		if !query.accepts(doc.item) {
+			continue
+		}
+		score, ok := doc.score(terms)
+		if !ok {
+			continue
+		}
+		if len(terms) > 1 && strings.Contains(doc.name, phrase) {
+			score += phraseBonus
+		}
+		results = append(results, Result{Item: doc.item, Menu: doc.menu, Score: score})
+	}
+
+	slices.SortStableFunc(results, func(a, b Result) int {
+		if byScore := cmp.Compare(b.Score, a.Score); byScore != 0 {
+			return byScore
+		}
+		return strings.Compare(a.Item.Name, b.Item.Name)
+	})
+	if query.Limit > 0 && len(results) > query.Limit {
+		results = results[:query.Limit]
+	}
+	return results
+}
+
+func (q Query) accepts(item *models.MenuItem) bool {
+	for _, tag := range q.Tags {
+		if !item.HasTag(tag) {
+			return false
+		}
+	}
+	price := item.GetPrice()
+	if q.MinPrice != nil && (!price.SameCurrency(*q.MinPrice) || price.LessThan(*q.MinPrice)) {
+		return false
+	}
+	if q.MaxPrice != nil && (!price.SameCurrency(*q.MaxPrice) || price.GreaterThan(*q.MaxPrice)) {
+		return false
+	}
+	return true
+}
+
+func (d document) score(terms []string) (float64, bool) {
+	total := 0.0
+	for _, term := range terms {
+		best := bestMatch(term, d.nameTokens)
+		if description := bestMatch(term, d.descTokens) * descriptionRatio; description > best {
+			best = description
+		}
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+	return total, true
+}
+
+// bestMatch compara la palabra buscada con cada palabra del documento.
+// El prefijo difuso permite que "pankake" encuentre "pancakes".
+func bestMatch(term string, tokens []string) float64 {
+	edits := maxEdits(term)
+	best := 0.0
+	for _, token := range tokens {
+		var score float64
+		switch {
+		case token == term:
+			score = exactScore
+		case strings.HasPrefix(token, term) && len(term) >= 3:
+			score = prefixScore
+		case edits > 0:
+			distance := float64(Distance(term, token))
+			if runes := []rune(token); len(runes) > len([]rune(term)) {
+				// Una coincidencia difusa solo con el prefijo vale un poco menos.
+				prefix := float64(Distance(term, string(runes[:len([]rune(term))])))
+				distance = min(distance, prefix+0.5)
+			}
+			if distance <= float64(edits)+0.5 {
+				score = fuzzyScore - distance
+			}
+		}
+		best = max(best, score)
+	}
+	return best
+}
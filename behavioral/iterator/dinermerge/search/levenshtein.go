@@ -0,0 +1,37 @@
+package search
+
+// Distance es la distancia de Levenshtein entre a y b, contada en runas.
+func Distance(a, b string) int {
+	source, target := []rune(a), []rune(b)
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(target)]
+}
+
+// maxEdits decide cuántos errores de tipeo se toleran según el largo de
+// la palabra buscada: ninguno en palabras cortas, dos en las largas.
+func maxEdits(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 5:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
@@ -0,0 +1,100 @@
+package search
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"slices"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"pankake", "pancake", 1},
+		{"crème", "creme", 1},
+	}
+	for _, tt := range tests {
+		if got := Distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Distance(tt.b, tt.a); got != tt.want {
+			t.Errorf("Distance(%q, %q) = %d, want %d", tt.b, tt.a, got, tt.want)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("Crème Brûlée, (Fakin') BLT & 2 eggs")
+	want := []string{"creme", "brulee", "fakin", "blt", "2", "eggs"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("Tokenize = %q, want %q", got, want)
+	}
+}
+
+func names(results []Result) []string {
+	var names []string
+	for _, result := range results {
+		names = append(names, result.Item.Name)
+	}
+	return names
+}
+
+// El índice cubre los menús de ejemplo del restaurante más un postre con
+// acentos dentro de una subsección y un ítem gratuito.
+func TestSearch(t *testing.T) {
+	breakfast := menu.NewCompositeMenu("Breakfast", "")
+	breakfast.AddSubMenu(menu.NewPancakeHouseMenu())
+	desserts := menu.NewCompositeMenu("Desserts", "")
+	desserts.AddItem(models.NewMenuItem("Crème Brûlée", "Custard with caramelised sugar", true, money.USD(4.25)))
+	dinner := menu.NewCompositeMenu("Dinner", "")
+	dinner.AddSubMenu(menu.NewDinerMenu())
+	dinner.AddSubMenu(desserts)
+	dinner.AddItem(models.NewMenuItem("Tap Water", "", true, money.USD(0)))
+	index := NewIndex(breakfast, dinner)
+
+	usd := func(amount float64) *money.Money {
+		price := money.USD(amount)
+		return &price
+	}
+	euros := money.EUR(10)
+
+	tests := []struct {
+		name    string
+		query   Query
+		want    []string
+		section string
+	}{
+		{name: "exact name", query: Query{Text: "pasta"}, want: []string{"Pasta"}, section: "Dinner"},
+		{name: "typo", query: Query{Text: "wafles"}, want: []string{"Waffles"}, section: "Breakfast"},
+		{name: "accents ignored", query: Query{Text: "creme brulee"}, want: []string{"Crème Brûlée"}, section: "Desserts"},
+		{name: "prefix", query: Query{Text: "hotd"}, want: []string{"Hotdog"}},
+		{name: "ties sorted by name", query: Query{Text: "blt"}, want: []string{"BLT", "Vegetarian BLT"}},
+		{name: "name beats description", query: Query{Text: "blueberries"}, want: []string{"Blueberry Pancakes", "Waffles"}},
+		{name: "phrase bonus", query: Query{Text: "pancake breakfast"}, want: []string{"K&B's Pancake Breakfast", "Regular Pancake Breakfast"}},
+		{name: "every term must match", query: Query{Text: "pasta waffles"}},
+		{name: "short words need exact match", query: Query{Text: "psta"}},
+		{name: "tags", query: Query{Tags: []models.DietaryTag{models.TagVegan}}, want: []string{"Steamed Veggies and Brown Rice", "Vegetarian BLT"}},
+		{name: "price range", query: Query{MinPrice: usd(3.50), MaxPrice: usd(3.60)}, want: []string{"Waffles"}},
+		{name: "free items only", query: Query{MaxPrice: usd(0)}, want: []string{"Tap Water"}},
+		{name: "zero minimum keeps free items", query: Query{Text: "water", MinPrice: usd(0)}, want: []string{"Tap Water"}},
+		{name: "other currency filters out", query: Query{MaxPrice: &euros}},
+		{name: "limit", query: Query{Text: "blt", Limit: 1}, want: []string{"BLT"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := index.Search(tt.query)
+			if got := names(results); !slices.Equal(got, tt.want) {
+				t.Fatalf("Search(%+v) = %q, want %q", tt.query, got, tt.want)
+			}
+			if tt.section != "" && results[0].Menu != tt.section {
+				t.Errorf("section = %q, want %q", results[0].Menu, tt.section)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package search
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"iter"
+)
+
+// sections indica de qué menú viene cada ítem: la sección más cercana en
+// los compuestos, o el nombre del menú si lo tiene.
+func sections(m menu.Menu) iter.Seq2[string, *models.MenuItem] {
+	if composite, ok := m.(*menu.CompositeMenu); ok {
+		return composite.Sections()
+	}
+	name := ""
+	if named, ok := m.(menu.NamedMenu); ok {
+		name = named.GetName()
+	}
+	return func(yield func(string, *models.MenuItem) bool) {
+		for item := range m.All() {
+			if !yield(name, item) {
+				return
+			}
+		}
+	}
+}
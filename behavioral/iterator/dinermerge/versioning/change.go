@@ -0,0 +1,121 @@
+package versioning
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type ChangeKind int
+
+const (
+	Added ChangeKind = iota
+	Removed
+	PriceChanged
+	DescriptionChanged
+	// Updated cubre los demás campos: vegetariano, etiquetas, alérgenos y horarios.
+	Updated
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case PriceChanged:
+		return "price changed"
+	case DescriptionChanged:
+		return "description changed"
+	default:
+		return "updated"
+	}
+}
+
+// Change describe un cambio de un ítem entre dos versiones. Before es nil
+// en los agregados y After es nil en los eliminados.
+type Change struct {
+	Kind   ChangeKind
+	Name   string
+	Before *models.MenuItem
+	After  *models.MenuItem
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%s: added at %s", c.Name, c.After.Price)
+	case Removed:
+		return fmt.Sprintf("%s: removed (was %s)", c.Name, c.Before.Price)
+	case PriceChanged:
+		return fmt.Sprintf("%s: price %s -> %s", c.Name, c.Before.Price, c.After.Price)
+	case DescriptionChanged:
+		return fmt.Sprintf("%s: description %q -> %q", c.Name, c.Before.Description, c.After.Description)
+	default:
+		return fmt.Sprintf("%s: %s updated", c.Name, strings.Join(updatedFields(c.Before, c.After), ", "))
+	}
+}
+
+// Diff compara dos listas de ítems por nombre. Primero informa los cambios
+// de los ítems que ya estaban, en su orden, y al final los agregados.
+func Diff(before, after []*models.MenuItem) []Change {
+	var changes []Change
+	for _, old := range before {
+		index := slices.IndexFunc(after, func(item *models.MenuItem) bool { return item.Name == old.Name })
+		if index < 0 {
+			changes = append(changes, Change{Kind: Removed, Name: old.Name, Before: old})
+			continue
+		}
+		changes = append(changes, compare(old, after[index])...)
+	}
+	for _, item := range after {
+		if !slices.ContainsFunc(before, func(old *models.MenuItem) bool { return old.Name == item.Name }) {
+			changes = append(changes, Change{Kind: Added, Name: item.Name, After: item})
+		}
+	}
+	return changes
+}
+
+func compare(before, after *models.MenuItem) []Change {
+	var changes []Change
+	if !before.Price.Equal(after.Price) {
+		changes = append(changes, Change{Kind: PriceChanged, Name: after.Name, Before: before, After: after})
+	}
+	if before.Description != after.Description {
+		changes = append(changes, Change{Kind: DescriptionChanged, Name: after.Name, Before: before, After: after})
+	}
+	if len(updatedFields(before, after)) > 0 {
+		changes = append(changes, Change{Kind: Updated, Name: after.Name, Before: before, After: after})
+	}
+	return changes
+}
+
+func updatedFields(before, after *models.MenuItem) []string {
+	var fields []string
+	if before.IsVegetarian() != after.IsVegetarian() {
+		fields = append(fields, "vegetarian")
+	}
+	if !slices.Equal(before.Tags, after.Tags) {
+		fields = append(fields, "tags")
+	}
+	if !slices.Equal(before.Allergens, after.Allergens) {
+		fields = append(fields, "allergens")
+	}
+	if !slices.EqualFunc(before.Available, after.Available, sameWindow) {
+		fields = append(fields, "availability")
+	}
+	return fields
+}
+
+func sameWindow(a, b models.Window) bool {
+	return a.Start == b.Start && a.End == b.End && slices.Equal(a.Days, b.Days)
+}
+
+func describe(changes []Change) string {
+	parts := make([]string, len(changes))
+	for i, change := range changes {
+		parts[i] = change.String()
+	}
+	return strings.Join(parts, "; ")
+}
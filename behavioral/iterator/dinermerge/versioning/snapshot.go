@@ -0,0 +1,44 @@
+package versioning
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"iter"
+)
+
+// Snapshot es un menú congelado en una versión; se puede recorrer,
+// imprimir o renderizar como cualquier otro menú.
+type Snapshot struct {
+	name    string
+	version int
+	items   []*models.MenuItem
+}
+
+func (s *Snapshot) GetName() string {
+	return s.name
+}
+
+func (s *Snapshot) GetDescription() string {
+	return ""
+}
+
+func (s *Snapshot) Version() int {
+	return s.version
+}
+
+// CreateIterator entrega copias para que nadie pueda alterar la historia.
+func (s *Snapshot) CreateIterator() iterator.Iterator[*models.MenuItem] {
+	items := make([]*models.MenuItem, len(s.items))
+	for i, item := range s.items {
+		items[i] = item.Clone()
+	}
+	return iterator.NewSliceIterator(items)
+}
+
+func (s *Snapshot) All() iter.Seq[*models.MenuItem] {
+	return iterator.SeqOf(s.CreateIterator)
+}
+
+func (s *Snapshot) Indexed() iter.Seq2[int, *models.MenuItem] {
+	return iterator.Enumerate(s.All())
+}
@@ -0,0 +1,198 @@
+package versioning
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/clock"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrUnknownVersion = errors.New("unknown menu version")
+
+type Version struct {
+	Number  int
+	Time    time.Time
+	Changes []Change
+	items   []*models.MenuItem
+}
+
+func (v Version) Summary() string {
+	if v.Number == 1 {
+		return fmt.Sprintf("initial version with %d items", len(v.items))
+	}
+	return describe(v.Changes)
+}
+
+// HistoryEntry es un cambio de un ítem junto con la versión que lo introdujo.
+type HistoryEntry struct {
+	Version int
+	Time    time.Time
+	Change  Change
+}
+
+// VersionedMenu envuelve un menú editable y guarda una copia de los ítems
+// después de cada cambio. Las ediciones tienen que pasar por él; los
+// cambios hechos directamente sobre el menú no quedan registrados.
+type VersionedMenu struct {
+	mu       sync.RWMutex
+	name     string
+	menu     menu.EditableMenu
+	clock    clock.Clock
+	versions []Version
+}
+
+func NewVersionedMenu(name string, m menu.EditableMenu, c clock.Clock) *VersionedMenu {
+	if c == nil {
+		c = clock.NewSystemClock()
+	}
+	v := &VersionedMenu{name: name, menu: m, clock: c}
+	v.versions = []Version{{Number: 1, Time: c.Now(), items: v.snapshot()}}
+	return v
+}
+
+func (v *VersionedMenu) GetName() string {
+	return v.name
+}
+
+func (v *VersionedMenu) GetDescription() string {
+	if named, ok := v.menu.(menu.NamedMenu); ok {
+		return named.GetDescription()
+	}
+	return ""
+}
+
+func (v *VersionedMenu) AddMenuItem(item *models.MenuItem) error {
+	return v.edit(func() error { return v.menu.AddMenuItem(item) })
+}
+
+func (v *VersionedMenu) RemoveItem(name string) error {
+	return v.edit(func() error { return v.menu.RemoveItem(name) })
+}
+
+func (v *VersionedMenu) UpdateItem(name string, updated *models.MenuItem) error {
+	return v.edit(func() error { return v.menu.UpdateItem(name, updated) })
+}
+
+// edit aplica el cambio y, si modificó algo, registra una versión nueva.
+func (v *VersionedMenu) edit(apply func() error) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if err := apply(); err != nil {
+		return err
+	}
+
+	current := v.snapshot()
+	latest := v.versions[len(v.versions)-1]
+	changes := Diff(latest.items, current)
+	if len(changes) == 0 {
+		return nil
+	}
+	v.versions = append(v.versions, Version{
+		Number:  latest.Number + 1,
+		Time:    v.clock.Now(),
+		Changes: changes,
+		items:   current,
+	})
+	return nil
+}
+
+func (v *VersionedMenu) snapshot() []*models.MenuItem {
+	var items []*models.MenuItem
+	for item := range v.menu.All() {
+		items = append(items, item.Clone())
+	}
+	return items
+}
+
+func (v *VersionedMenu) CurrentVersion() int {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.versions[len(v.versions)-1].Number
+}
+
+func (v *VersionedMenu) Versions() []Version {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	versions := make([]Version, len(v.versions))
+	copy(versions, v.versions)
+	return versions
+}
+
+// At devuelve el menú tal como estaba en esa versión. Es de solo lectura.
+func (v *VersionedMenu) At(number int) (*Snapshot, error) {
+	version, err := v.version(number)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{
+		name:    fmt.Sprintf("%s (v%d)", v.name, version.Number),
+		version: version.Number,
+		items:   version.items,
+	}, nil
+}
+
+func (v *VersionedMenu) Diff(from, to int) ([]Change, error) {
+	before, err := v.version(from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := v.version(to)
+	if err != nil {
+		return nil, err
+	}
+	return Diff(before.items, after.items), nil
+}
+
+// History lista los cambios de un ítem en todas las versiones.
+func (v *VersionedMenu) History(name string) []HistoryEntry {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	var history []HistoryEntry
+	for _, version := range v.versions {
+		for _, change := range version.Changes {
+			if strings.EqualFold(change.Name, name) {
+				history = append(history, HistoryEntry{Version: version.Number, Time: version.Time, Change: change})
+			}
+		}
+	}
+	return history
+}
+
+func (v *VersionedMenu) version(number int) (Version, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if number < 1 || number > len(v.versions) {
+		return Version{}, fmt.Errorf("%w: %d", ErrUnknownVersion, number)
+	}
+	return v.versions[number-1], nil
+}
+
+func (v *VersionedMenu) CreateIterator() iterator.Iterator[*models.MenuItem] {
+	return v.menu.CreateIterator()
+}
+
+// CreateCursor borra a través del VersionedMenu, así cada Remove queda
+// registrado como una versión.
+func (v *VersionedMenu) CreateCursor() iterator.Cursor[*models.MenuItem] {
+	var items []*models.MenuItem
+	for item := range v.menu.All() {
+		items = append(items, item)
+	}
+	return iterator.NewSliceCursor(items, func(item *models.MenuItem) error {
+		return v.RemoveItem(item.Name)
+	})
+}
+
+func (v *VersionedMenu) All() iter.Seq[*models.MenuItem] {
+	return v.menu.All()
+}
+
+func (v *VersionedMenu) Indexed() iter.Seq2[int, *models.MenuItem] {
+	return v.menu.Indexed()
+}
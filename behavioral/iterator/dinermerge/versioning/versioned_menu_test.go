@@ -0,0 +1,68 @@
+package versioning
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/clock"
+	"designpatterns/internal/money"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestVersionedMenuRecordsChanges(t *testing.T) {
+	c := clock.NewFakeClock(time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC))
+	v := NewVersionedMenu("Pancakes", menu.NewPancakeHouseMenu(), c)
+	waffles := models.NewMenuItem("Waffles", "Belgian waffles", true, money.USD(3.99))
+	if err := v.UpdateItem("Waffles", waffles); err != nil {
+		t.Fatalf("UpdateItem: %v", err)
+	}
+	if err := v.RemoveItem("Blueberry Pancakes"); err != nil {
+		t.Fatalf("RemoveItem: %v", err)
+	}
+	if err := v.AddMenuItem(models.NewMenuItem("Crepes", "", true, money.USD(4))); err != nil {
+		t.Fatalf("AddMenuItem: %v", err)
+	}
+	if err := v.RemoveItem("Nope"); !errors.Is(err, menu.ErrItemNotFound) {
+		t.Fatalf("err = %v, want ErrItemNotFound", err)
+	}
+	if got := v.CurrentVersion(); got != 4 {
+		t.Fatalf("CurrentVersion = %d, want 4 (failed edits add no version)", got)
+	}
+
+	changes, err := v.Diff(1, 4)
+	if err != nil {
+		t.Fatalf("Diff: %v", err)
+	}
+	var kinds []ChangeKind
+	for _, c := range changes {
+		kinds = append(kinds, c.Kind)
+	}
+	// El ítem nuevo no trae los alérgenos del original: eso es un Updated
+	want := []ChangeKind{Removed, PriceChanged, DescriptionChanged, Updated, Added}
+	if !slices.Equal(kinds, want) {
+		t.Fatalf("kinds = %v, want %v", kinds, want)
+	}
+
+	if history := v.History("waffles"); len(history) != 3 || history[0].Version != 2 {
+		t.Fatalf("History = %+v", history)
+	}
+	if _, err := v.At(5); !errors.Is(err, ErrUnknownVersion) {
+		t.Fatalf("err = %v, want ErrUnknownVersion", err)
+	}
+}
+
+func TestSnapshotAllCanBeRangedTwice(t *testing.T) {
+	v := NewVersionedMenu("Pancakes", menu.NewPancakeHouseMenu(), clock.NewFakeClock(time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)))
+	snapshot, err := v.At(1)
+	if err != nil {
+		t.Fatalf("At: %v", err)
+	}
+	all := snapshot.All()
+	first := len(slices.Collect(all))
+	second := len(slices.Collect(all))
+	if first == 0 || first != second {
+		t.Fatalf("first range %d items, second %d", first, second)
+	}
+}
@@ -4,40 +4,211 @@ import (
 	"designpatterns/behavioral/iterator/dinermerge/iterator"
 	"designpatterns/behavioral/iterator/dinermerge/menu"
 	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/behavioral/iterator/dinermerge/render"
+	"designpatterns/internal/clock"
+	"designpatterns/internal/money"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
 )
 
 type Waitress struct {
-	PancakeHouseMenu menu.Menu
-	DinerMenu        menu.Menu
+	menus    []menu.Menu
+	locale   money.Locale
+	clock    clock.Clock
+	renderer render.Renderer
+	out      io.Writer
 }
 
-func NewWaitress(pancakeHouseMenu menu.Menu, dinerMenu menu.Menu) *Waitress {
+func NewWaitress(menus ...menu.Menu) *Waitress {
 	return &Waitress{
-		PancakeHouseMenu: pancakeHouseMenu,
-		DinerMenu:        dinerMenu,
+		menus:    menus,
+		locale:   money.EnUS,
+		clock:    clock.NewSystemClock(),
+		renderer: render.NewTextRenderer(),
+		out:      os.Stdout,
 	}
 }
 
-func (w *Waitress) PrintMenu() {
-	pancakeIterator := w.PancakeHouseMenu.CreateIterator()
-	dinerIterator := w.DinerMenu.CreateIterator()
+// SetRenderer cambia el formato de los Print*: texto, Markdown, HTML o JSON.
+func (w *Waitress) SetRenderer(r render.Renderer) {
+	w.renderer = r
+}
+
+func (w *Waitress) SetOutput(out io.Writer) {
+	w.out = out
+}
+
+func (w *Waitress) SetClock(c clock.Clock) {
+	w.clock = c
+}
+
+// SetLocale cambia cómo se imprimen los precios (p. ej. money.EsES).
+func (w *Waitress) SetLocale(locale money.Locale) {
+	w.locale = locale
+}
 
-	println("MENU\n----\nBREAKFAST")
-	w.printMenu(pancakeIterator)
-	println("\nLUNCH")
-	w.printMenu(dinerIterator)
+func (w *Waitress) AddMenu(m menu.Menu) {
+	w.menus = append(w.menus, m)
 }
 
-func (w *Waitress) printMenu(iterator iterator.Iterator[*models.MenuItem]) {
-	for iterator.HasNext() {
-		menuItem, err := iterator.Next()
-		if err != nil {
-			println("Error:", err.Error())
-			continue
+func (w *Waitress) Menus() []menu.Menu {
+	menus := make([]menu.Menu, len(w.menus))
+	copy(menus, w.menus)
+	return menus
+}
+
+// AllItems recorre en profundidad todos los ítems de todos los menús.
+func (w *Waitress) AllItems() iterator.Iterator[*models.MenuItem] {
+	iterators := make([]iterator.Iterator[*models.MenuItem], 0, len(w.menus))
+	for _, m := range w.menus {
+		iterators = append(iterators, m.CreateIterator())
+	}
+	return iterator.NewCompositeIterator(iterators...)
+}
+
+// AvailableItems recorre solo lo que se puede pedir a la hora del reloj.
+func (w *Waitress) AvailableItems() iterator.Iterator[*models.MenuItem] {
+	return w.AvailableItemsAt(w.clock.Now())
+}
+
+func (w *Waitress) AvailableItemsAt(t time.Time) iterator.Iterator[*models.MenuItem] {
+	var iterators []iterator.Iterator[*models.MenuItem]
+	for _, m := range w.menus {
+		if menu.IsAvailableAt(m, t) {
+			iterators = append(iterators, availableItems(m, t))
 		}
-		println(menuItem.Name, "-", menuItem.Description, "(", menuItem.Price, ")")
-		if menuItem.Vegetarian {
-			println("(Vegetarian)")
+	}
+	return iterator.NewCompositeIterator(iterators...)
+}
+
+func availableItems(m menu.Menu, t time.Time) iterator.Iterator[*models.MenuItem] {
+	composite, ok := m.(*menu.CompositeMenu)
+	if !ok {
+		return iterator.Filter(m.CreateIterator(), func(item *models.MenuItem) bool {
+			return item.IsAvailableAt(t)
+		})
+	}
+
+	var iterators []iterator.Iterator[*models.MenuItem]
+	for _, entry := range composite.Entries() {
+		switch {
+		case entry.SubMenu == nil && entry.Item.IsAvailableAt(t):
+			iterators = append(iterators, iterator.NewSliceIterator([]*models.MenuItem{entry.Item}))
+		case entry.SubMenu != nil && menu.IsAvailableAt(entry.SubMenu, t):
+			iterators = append(iterators, availableItems(entry.SubMenu, t))
 		}
 	}
+	return iterator.NewCompositeIterator(iterators...)
+}
+
+func (w *Waitress) PrintMenu() {
+	w.print(render.NewDocument("MENU", w.options(time.Time{}), w.menus...))
+}
+
+// PrintAvailableMenu imprime solo los menús e ítems disponibles ahora.
+func (w *Waitress) PrintAvailableMenu() {
+	w.PrintMenuAt(w.clock.Now())
+}
+
+func (w *Waitress) PrintMenuAt(t time.Time) {
+	w.print(render.NewDocument("MENU ("+t.Format("Mon 15:04")+")", w.options(t), w.menus...))
+}
+
+// Render escribe todos los menús con el renderer indicado, por ejemplo
+// HTML para la web o JSON para la app.
+func (w *Waitress) Render(out io.Writer, r render.Renderer) error {
+	doc, err := render.NewDocument("MENU", w.options(time.Time{}), w.menus...)
+	if err != nil {
+		return err
+	}
+	return r.Render(out, doc)
+}
+
+// FindItem busca por nombre, sin distinguir mayúsculas, en todos los menús.
+func (w *Waitress) FindItem(name string) (*models.MenuItem, error) {
+	found := iterator.Filter(w.AllItems(), func(item *models.MenuItem) bool {
+		return strings.EqualFold(item.Name, name)
+	})
+	if !found.HasNext() {
+		return nil, fmt.Errorf("%w: %s", menu.ErrItemNotFound, name)
+	}
+	return found.Next()
+}
+
+// FindAvailableItem busca el ítem entre lo que se sirve ahora; si existe
+// pero no está disponible devuelve menu.ErrItemUnavailable.
+func (w *Waitress) FindAvailableItem(name string) (*models.MenuItem, error) {
+	return w.FindItemAt(name, w.clock.Now())
+}
+
+func (w *Waitress) FindItemAt(name string, t time.Time) (*models.MenuItem, error) {
+	found := iterator.Filter(w.AvailableItemsAt(t), func(item *models.MenuItem) bool {
+		return strings.EqualFold(item.Name, name)
+	})
+	if found.HasNext() {
+		return found.Next()
+	}
+	if _, err := w.FindItem(name); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: %s at %s", menu.ErrItemUnavailable, name, t.Format("Mon 15:04"))
+}
+
+func (w *Waitress) IsItemVegetarian(name string) (bool, error) {
+	item, err := w.FindItem(name)
+	if err != nil {
+		return false, err
+	}
+	return item.IsVegetarian(), nil
+}
+
+func (w *Waitress) FindItems(query models.DietaryQuery) iterator.Iterator[*models.MenuItem] {
+	return iterator.Filter(w.AllItems(), query.Matches)
+}
+
+func (w *Waitress) PrintVegetarianMenu() {
+	w.printItems("VEGETARIAN MENU", w.FindItems(models.DietaryQuery{Require: []models.DietaryTag{models.TagVegetarian}}))
+}
+
+func (w *Waitress) PrintDietaryMenu(query models.DietaryQuery) {
+	w.printItems(describeQuery(query), w.FindItems(query))
+}
+
+func (w *Waitress) PrintItems(items iterator.Iterator[*models.MenuItem]) {
+	w.printItems("", items)
+}
+
+func (w *Waitress) printItems(title string, items iterator.Iterator[*models.MenuItem]) {
+	w.print(render.FromItems(title, w.options(time.Time{}), items))
+}
+
+func (w *Waitress) print(doc render.Document, err error) {
+	if err == nil {
+		fmt.Fprintln(w.out)
+		err = w.renderer.Render(w.out, doc)
+	}
+	if err != nil {
+		fmt.Fprintln(w.out, "Error:", err)
+	}
+}
+
+func (w *Waitress) options(at time.Time) render.Options {
+	return render.Options{Locale: w.locale, At: at}
+}
+
+func describeQuery(query models.DietaryQuery) string {
+	var parts []string
+	for _, tag := range query.Require {
+		parts = append(parts, strings.ToUpper(string(tag)))
+	}
+	for _, allergen := range query.Exclude {
+		parts = append(parts, "NO "+strings.ToUpper(string(allergen)))
+	}
+	if len(parts) == 0 {
+		return "ALL ITEMS"
+	}
+	return strings.Join(parts, ", ")
 }
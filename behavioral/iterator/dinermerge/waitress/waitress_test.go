@@ -0,0 +1,108 @@
+package waitress
+
+import (
+	"designpatterns/behavioral/iterator/dinermerge/iterator"
+	"designpatterns/behavioral/iterator/dinermerge/menu"
+	"designpatterns/behavioral/iterator/dinermerge/models"
+	"designpatterns/internal/money"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func itemNames(it iterator.Iterator[*models.MenuItem]) []string {
+	var names []string
+	for it.HasNext() {
+		item, _ := it.Next()
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestFindItems(t *testing.T) {
+	w := NewWaitress(menu.NewDinerMenu())
+	tests := []struct {
+		name  string
+		query models.DietaryQuery
+		want  []string
+	}{
+		{
+			name:  "vegan counts as vegetarian",
+			query: models.DietaryQuery{Require: []models.DietaryTag{models.TagVegetarian}},
+			want:  []string{"Vegetarian BLT", "Steamed Veggies and Brown Rice", "Pasta"},
+		},
+		{
+			name:  "every tag required",
+			query: models.DietaryQuery{Require: []models.DietaryTag{models.TagVegan, models.TagGlutenFree}},
+			want:  []string{"Steamed Veggies and Brown Rice"},
+		},
+		{
+			name:  "allergens excluded",
+			query: models.DietaryQuery{Exclude: []models.Allergen{models.AllergenGluten, models.AllergenEggs}},
+			want:  []string{"Steamed Veggies and Brown Rice"},
+		},
+		{
+			name:  "empty query",
+			query: models.DietaryQuery{},
+			want:  []string{"Vegetarian BLT", "BLT", "Soup of the day", "Hotdog", "Steamed Veggies and Brown Rice", "Pasta"},
+		},
+	}
+	for _, tt := range tests {
+		if got := itemNames(w.FindItems(tt.query)); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: FindItems = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeQuery(t *testing.T) {
+	query := models.DietaryQuery{
+		Require: []models.DietaryTag{models.TagVegan},
+		Exclude: []models.Allergen{models.AllergenGluten},
+	}
+	if got := describeQuery(query); got != "VEGAN, NO GLUTEN" {
+		t.Errorf("describeQuery = %q", got)
+	}
+	if got := describeQuery(models.DietaryQuery{}); got != "ALL ITEMS" {
+		t.Errorf("describeQuery(empty) = %q", got)
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	breakfast := menu.NewCompositeMenu("Breakfast", "").WithAvailability(models.BreakfastHours)
+	breakfast.AddSubMenu(menu.NewPancakeHouseMenu())
+	breakfast.AddItem(models.NewMenuItem("Brunch Special", "", false, money.USD(5.99)).
+		WithAvailability(models.On(models.Weekends, models.At(9, 0), models.At(11, 0))))
+	w := NewWaitress(breakfast, menu.NewDinerMenu())
+
+	// El 3 de junio de 2024 fue lunes y el 8, sábado.
+	monday8 := time.Date(2024, time.June, 3, 8, 0, 0, 0, time.UTC)
+	saturday10 := time.Date(2024, time.June, 8, 10, 0, 0, 0, time.UTC)
+	saturday20 := time.Date(2024, time.June, 8, 20, 0, 0, 0, time.UTC)
+
+	pancakes := []string{"K&B's Pancake Breakfast", "Regular Pancake Breakfast", "Blueberry Pancakes", "Waffles"}
+	diner := []string{"Vegetarian BLT", "BLT", "Soup of the day", "Hotdog", "Steamed Veggies and Brown Rice", "Pasta"}
+	tests := []struct {
+		at   time.Time
+		want []string
+	}{
+		{monday8, slices.Concat(pancakes, diner)},
+		{saturday10, slices.Concat(pancakes, []string{"Brunch Special"}, diner)},
+		{saturday20, diner},
+	}
+	for _, tt := range tests {
+		if got := itemNames(w.AvailableItemsAt(tt.at)); !slices.Equal(got, tt.want) {
+			t.Errorf("AvailableItemsAt(%s) = %q, want %q", tt.at.Format("Mon 15:04"), got, tt.want)
+		}
+	}
+
+	if item, err := w.FindItemAt("waffles", monday8); err != nil || item.Name != "Waffles" {
+		t.Errorf("FindItemAt(waffles) = %v, %v", item, err)
+	}
+	if _, err := w.FindItemAt("Brunch Special", monday8); !errors.Is(err, menu.ErrItemUnavailable) {
+		t.Errorf("brunch on a monday: err = %v, want ErrItemUnavailable", err)
+	}
+	if _, err := w.FindItemAt("Lobster", monday8); !errors.Is(err, menu.ErrItemNotFound) {
+		t.Errorf("err = %v, want ErrItemNotFound", err)
+	}
+}
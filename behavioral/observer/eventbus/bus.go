@@ -0,0 +1,80 @@
+package eventbus
+
+import (
+	"sync"
+	"time"
+)
+
+type Bus[T any] struct {
+	mu            sync.RWMutex
+	subscriptions []*Subscription[T]
+	now           func() time.Time
+}
+
+func NewBus[T any]() *Bus[T] {
+	return &Bus[T]{now: time.Now}
+}
+
+func (b *Bus[T]) SetTimeSource(now func() time.Time) {
+	b.now = now
+}
+
+func (b *Bus[T]) Subscribe(pattern string, s Subscriber[T], opts ...Option[T]) *Subscription[T] {
+	sub := newSubscription(pattern, s, opts)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.subscriptions = append(b.subscriptions, sub)
+	return sub
+}
+
+func (b *Bus[T]) Unsubscribe(sub *Subscription[T]) {
+	b.mu.Lock()
+	for i, s := range b.subscriptions {
+		if s == sub {
+			b.subscriptions = append(b.subscriptions[:i], b.subscriptions[i+1:]...)
+			break
+		}
+	}
+	b.mu.Unlock()
+
+	sub.close()
+}
+
+func (b *Bus[T]) Publish(topic string, payload T) {
+	event := Event[T]{Topic: topic, Payload: payload, Time: b.now()}
+
+	// Se copia la lista para que un suscriptor pueda desuscribirse
+	// durante la entrega sin bloquear el bus.
+	b.mu.RLock()
+	targets := make([]*Subscription[T], 0, len(b.subscriptions))
+	for _, s := range b.subscriptions {
+		if Match(s.pattern, topic) {
+			targets = append(targets, s)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, s := range targets {
+		s.deliver(event)
+	}
+}
+
+func (b *Bus[T]) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscriptions)
+}
+
+// Close desuscribe a todos sin esperar las entregas asíncronas; para eso
+// está Subscription.Wait.
+func (b *Bus[T]) Close() {
+	b.mu.Lock()
+	subscriptions := b.subscriptions
+	b.subscriptions = nil
+	b.mu.Unlock()
+
+	for _, s := range subscriptions {
+		s.close()
+	}
+}
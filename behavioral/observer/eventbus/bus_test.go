@@ -0,0 +1,139 @@
+package eventbus
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBusDeliversSyncInOrder(t *testing.T) {
+	bus := NewBus[int]()
+	var got []int
+	bus.Subscribe("numbers.*", SubscriberFunc[int](func(event Event[int]) error {
+		got = append(got, event.Payload)
+		return nil
+	}))
+
+	bus.Publish("numbers.a", 1)
+	bus.Publish("letters.a", 2)
+	bus.Publish("numbers.b", 3)
+
+	if len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Fatalf("got %v, want [1 3]", got)
+	}
+}
+
+func TestBusReportsHandlerErrorsAndPanics(t *testing.T) {
+	bus := NewBus[int]()
+	var reported []string
+	onError := WithErrorHandler[int](func(event Event[int], err error) {
+		reported = append(reported, err.Error())
+	})
+	bus.Subscribe("t", SubscriberFunc[int](func(Event[int]) error { return errors.New("boom") }), onError)
+	bus.Subscribe("t", SubscriberFunc[int](func(Event[int]) error { panic("kaput") }), onError)
+
+	bus.Publish("t", 1)
+
+	if len(reported) != 2 || reported[0] != "boom" || reported[1] != "subscriber panic: kaput" {
+		t.Fatalf("reported %v", reported)
+	}
+}
+
+func TestAsyncSubscriptionDrainsQueueOnClose(t *testing.T) {
+	bus := NewBus[int]()
+	var got []int
+	sub := bus.Subscribe("t", SubscriberFunc[int](func(event Event[int]) error {
+		got = append(got, event.Payload)
+		return nil
+	}), WithAsync[int](8))
+
+	for i := range 5 {
+		bus.Publish("t", i)
+	}
+	bus.Close()
+	sub.Wait()
+
+	if len(got) != 5 {
+		t.Fatalf("got %v, want 5 events", got)
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("got %v, want events in publish order", got)
+		}
+	}
+}
+
+// waitOrFail falla si el suscriptor no termina: antes un handler asíncrono
+// que se desuscribía esperaba a su propia goroutine para siempre.
+func waitOrFail(t *testing.T, sub *Subscription[int]) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		sub.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("subscription did not stop: deadlock")
+	}
+}
+
+func TestAsyncHandlerCanUnsubscribeItself(t *testing.T) {
+	tests := []struct {
+		name string
+		stop func(bus *Bus[int], sub *Subscription[int])
+	}{
+		{"Unsubscribe", func(bus *Bus[int], sub *Subscription[int]) { bus.Unsubscribe(sub) }},
+		{"Close", func(bus *Bus[int], _ *Subscription[int]) { bus.Close() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := NewBus[int]()
+			var sub *Subscription[int]
+			ready := make(chan struct{})
+			sub = bus.Subscribe("t", SubscriberFunc[int](func(Event[int]) error {
+				<-ready
+				tt.stop(bus, sub)
+				return nil
+			}), WithAsync[int](1))
+			close(ready)
+
+			bus.Publish("t", 1)
+			waitOrFail(t, sub)
+			if bus.Len() != 0 {
+				t.Fatalf("Len = %d, want 0", bus.Len())
+			}
+		})
+	}
+}
+
+func TestPublishOnFullQueueDoesNotBlockUnsubscribe(t *testing.T) {
+	bus := NewBus[int]()
+	release := make(chan struct{})
+	var sub *Subscription[int]
+	sub = bus.Subscribe("t", SubscriberFunc[int](func(Event[int]) error {
+		<-release
+		bus.Unsubscribe(sub)
+		return nil
+	}), WithAsync[int](1))
+
+	// El primer evento ocupa al handler, el segundo llena la cola y el
+	// tercero deja a Publish esperando espacio.
+	published := make(chan struct{})
+	go func() {
+		for i := range 3 {
+			bus.Publish("t", i)
+		}
+		close(published)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	waitOrFail(t, sub)
+	select {
+	case <-published:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Publish stayed blocked after the subscription closed")
+	}
+}
@@ -0,0 +1,27 @@
+package eventbus
+
+import "time"
+
+type Event[T any] struct {
+	Topic   string
+	Payload T
+	Time    time.Time
+}
+
+type Subscriber[T any] interface {
+	Handle(event Event[T]) error
+}
+
+type SubscriberFunc[T any] func(event Event[T]) error
+
+func (f SubscriberFunc[T]) Handle(event Event[T]) error {
+	return f(event)
+}
+
+type ErrorHandler[T any] func(event Event[T], err error)
+
+type Publisher[T any] interface {
+	Subscribe(pattern string, s Subscriber[T], opts ...Option[T]) *Subscription[T]
+	Unsubscribe(sub *Subscription[T])
+	Publish(topic string, payload T)
+}
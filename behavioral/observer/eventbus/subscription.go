@@ -0,0 +1,134 @@
+package eventbus
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+type Option[T any] func(s *Subscription[T])
+
+// WithAsync entrega los eventos en una goroutine propia del suscriptor,
+// conservando el orden de publicación.
+func WithAsync[T any](buffer int) Option[T] {
+	return func(s *Subscription[T]) {
+		s.queue = make(chan Event[T], buffer)
+		s.quit = make(chan struct{})
+		s.stopped = make(chan struct{})
+	}
+}
+
+func WithErrorHandler[T any](handler ErrorHandler[T]) Option[T] {
+	return func(s *Subscription[T]) {
+		s.onError = handler
+	}
+}
+
+type Subscription[T any] struct {
+	pattern    string
+	subscriber Subscriber[T]
+	onError    ErrorHandler[T]
+	queue      chan Event[T]
+	quit       chan struct{}
+	stopped    chan struct{}
+	mu         sync.RWMutex
+	closed     bool
+}
+
+func newSubscription[T any](pattern string, subscriber Subscriber[T], opts []Option[T]) *Subscription[T] {
+	s := &Subscription[T]{
+		pattern:    pattern,
+		subscriber: subscriber,
+		onError:    defaultErrorHandler[T],
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.queue != nil {
+		go s.run()
+	}
+	return s
+}
+
+func (s *Subscription[T]) Pattern() string {
+	return s.pattern
+}
+
+func (s *Subscription[T]) IsAsync() bool {
+	return s.queue != nil
+}
+
+func (s *Subscription[T]) deliver(event Event[T]) {
+	s.mu.RLock()
+	closed := s.closed
+	s.mu.RUnlock()
+	if closed {
+		return
+	}
+	if s.queue == nil {
+		s.handle(event)
+		return
+	}
+	// Sin lock: con la cola llena el handler debe poder desuscribirse
+	select {
+	case s.queue <- event:
+	case <-s.quit:
+	}
+}
+
+func (s *Subscription[T]) handle(event Event[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.onError(event, fmt.Errorf("subscriber panic: %v", r))
+		}
+	}()
+	if err := s.subscriber.Handle(event); err != nil {
+		s.onError(event, err)
+	}
+}
+
+func (s *Subscription[T]) run() {
+	defer close(s.stopped)
+	for {
+		select {
+		case event := <-s.queue:
+			s.handle(event)
+		case <-s.quit:
+			// Procesa lo que quedó en la cola antes de terminar
+			for {
+				select {
+				case event := <-s.queue:
+					s.handle(event)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// Wait bloquea hasta que un suscriptor asíncrono cerrado termina de
+// procesar su cola. No debe llamarse desde el propio handler.
+func (s *Subscription[T]) Wait() {
+	if s.stopped != nil {
+		<-s.stopped
+	}
+}
+
+// close no espera a la goroutine de entrega, así que un handler puede
+// desuscribirse o cerrar el bus sin bloquearse a sí mismo.
+func (s *Subscription[T]) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	s.closed = true
+	if s.quit != nil {
+		close(s.quit)
+	}
+}
+
+func defaultErrorHandler[T any](event Event[T], err error) {
+	fmt.Fprintf(os.Stderr, "eventbus: %s: %v\n", event.Topic, err)
+}
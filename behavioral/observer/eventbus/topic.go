@@ -0,0 +1,24 @@
+package eventbus
+
+import "strings"
+
+// Match compara un tópico con un patrón separado por puntos: "*" acepta
+// exactamente un segmento y "#" acepta cero o más segmentos restantes.
+func Match(pattern string, topic string) bool {
+	return matchSegments(strings.Split(pattern, "."), strings.Split(topic, "."))
+}
+
+func matchSegments(pattern []string, topic []string) bool {
+	for i, segment := range pattern {
+		if segment == "#" {
+			return true
+		}
+		if i >= len(topic) {
+			return false
+		}
+		if segment != "*" && segment != topic[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(topic)
+}
@@ -0,0 +1,26 @@
+package eventbus
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		topic   string
+		want    bool
+	}{
+		{"weather.measurements.BOG", "weather.measurements.BOG", true},
+		{"weather.measurements.BOG", "weather.measurements.MDE", false},
+		{"weather.measurements.*", "weather.measurements.BOG", true},
+		{"weather.measurements.*", "weather.measurements", false},
+		{"weather.measurements.*", "weather.measurements.BOG.raw", false},
+		{"weather.#", "weather", true},
+		{"weather.#", "weather.faults.BOG", true},
+		{"*.faults.#", "weather.faults.BOG", true},
+		{"weather", "weather.faults", false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.topic); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.topic, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package alerts
+
+import "time"
+
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+type EventType string
+
+const (
+	EventRaised  EventType = "raised"
+	EventUpdated EventType = "updated"
+	EventCleared EventType = "cleared"
+)
+
+type Alert struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	Value    float64
+	Since    time.Time
+}
+
+type Event struct {
+	Type  EventType
+	Alert Alert
+	Time  time.Time
+}
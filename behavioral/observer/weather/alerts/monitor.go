@@ -0,0 +1,108 @@
+package alerts
+
+import (
+	"designpatterns/internal/clock"
+	"time"
+)
+
+type ruleState struct {
+	rule         Rule
+	pendingSince time.Time
+	active       *Alert
+}
+
+// Monitor es un listener de WeatherData que evalúa las reglas en cada
+// medición y notifica el ciclo de vida de cada alerta.
+type Monitor struct {
+	clock     clock.Clock
+	states    []*ruleState
+	notifiers []Notifier
+}
+
+func NewMonitor(c clock.Clock, rules ...Rule) *Monitor {
+	if c == nil {
+		c = clock.NewSystemClock()
+	}
+	m := &Monitor{clock: c}
+	for _, rule := range rules {
+		m.AddRule(rule)
+	}
+	return m
+}
+
+func (m *Monitor) AddRule(rule Rule) {
+	m.states = append(m.states, &ruleState{rule: rule})
+}
+
+func (m *Monitor) AddNotifier(n Notifier) {
+	m.notifiers = append(m.notifiers, n)
+}
+
+func (m *Monitor) Update(temperature float64, humidity float64, pressure float64) {
+	reading := Reading{
+		Time:        m.clock.Now(),
+		Temperature: temperature,
+		Humidity:    humidity,
+		Pressure:    pressure,
+	}
+
+	for _, state := range m.states {
+		m.evaluate(state, reading)
+	}
+}
+
+func (m *Monitor) Active() []Alert {
+	var active []Alert
+	for _, state := range m.states {
+		if state.active != nil {
+			active = append(active, *state.active)
+		}
+	}
+	return active
+}
+
+func (m *Monitor) evaluate(state *ruleState, reading Reading) {
+	check := state.rule.Condition.Observe(reading)
+
+	if state.active != nil {
+		switch {
+		case check.Cleared:
+			cleared := *state.active
+			cleared.Value = check.Value
+			state.active = nil
+			state.pendingSince = time.Time{}
+			m.notify(Event{Type: EventCleared, Alert: cleared, Time: reading.Time})
+		case check.Value != state.active.Value:
+			state.active.Value = check.Value
+			state.active.Message = check.Message
+			m.notify(Event{Type: EventUpdated, Alert: *state.active, Time: reading.Time})
+		}
+		return
+	}
+
+	if !check.Triggered {
+		state.pendingSince = time.Time{}
+		return
+	}
+	if state.pendingSince.IsZero() {
+		state.pendingSince = reading.Time
+	}
+	if reading.Time.Sub(state.pendingSince) < state.rule.For {
+		return
+	}
+
+	state.active = &Alert{
+		Rule:     state.rule.Name,
+		Severity: state.rule.Severity,
+		Message:  check.Message,
+		Value:    check.Value,
+		Since:    state.pendingSince,
+	}
+	m.notify(Event{Type: EventRaised, Alert: *state.active, Time: reading.Time})
+}
+
+func (m *Monitor) notify(event Event) {
+	for _, n := range m.notifiers {
+		n.Notify(event)
+	}
+}
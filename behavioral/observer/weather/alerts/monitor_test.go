@@ -0,0 +1,96 @@
+package alerts
+
+import (
+	"designpatterns/internal/clock"
+	"slices"
+	"testing"
+	"time"
+)
+
+var noon = time.Date(2024, time.June, 3, 12, 0, 0, 0, time.UTC)
+
+// eventLog guarda los eventos en el orden en que se notifican.
+type eventLog []Event
+
+func (l *eventLog) Notify(event Event) {
+	*l = append(*l, event)
+}
+
+func (l eventLog) types() []EventType {
+	types := make([]EventType, len(l))
+	for i, event := range l {
+		types[i] = event.Type
+	}
+	return types
+}
+
+func TestThresholdHysteresis(t *testing.T) {
+	var events eventLog
+	m := NewMonitor(clock.NewFakeClock(noon), FrostWarning())
+	m.AddNotifier(&events)
+
+	for _, temperature := range []float64{1, -1, -2, 1, 1.5, 3, -0.5} {
+		m.Update(temperature, 50, 1013)
+	}
+	// Entre 0 y 2 grados la alerta sigue activa: no oscila.
+	want := []EventType{EventRaised, EventUpdated, EventUpdated, EventUpdated, EventCleared, EventRaised}
+	if got := events.types(); !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if active := m.Active(); len(active) != 1 || active[0].Value != -0.5 {
+		t.Fatalf("active = %+v", active)
+	}
+}
+
+func TestRuleMustHoldFor(t *testing.T) {
+	c := clock.NewFakeClock(noon)
+	var events eventLog
+	m := NewMonitor(c, HeatWarning())
+	m.AddNotifier(&events)
+
+	// Minutos desde el mediodía y temperatura de cada lectura. Bajar del
+	// umbral a los 6 minutos reinicia la espera de 10 minutos.
+	readings := []struct {
+		minute      int
+		temperature float64
+	}{
+		{0, 36}, {5, 36}, {6, 34}, {7, 36}, {16, 36},
+	}
+	for _, r := range readings {
+		c.Set(noon.Add(time.Duration(r.minute) * time.Minute))
+		m.Update(r.temperature, 40, 1013)
+	}
+	if len(events) != 0 {
+		t.Fatalf("raised too early: %v", events.types())
+	}
+
+	c.Set(noon.Add(17 * time.Minute))
+	m.Update(36, 40, 1013)
+	if len(events) != 1 || events[0].Type != EventRaised {
+		t.Fatalf("events = %v", events.types())
+	}
+	if since := events[0].Alert.Since; !since.Equal(noon.Add(7 * time.Minute)) {
+		t.Fatalf("Since = %s, want the first reading of the current run", since)
+	}
+}
+
+func TestPressureDrop(t *testing.T) {
+	c := clock.NewFakeClock(noon)
+	var events eventLog
+	m := NewMonitor(c, StormWarning())
+	m.AddNotifier(&events)
+
+	// Una lectura por hora. Las máximas de hace más de tres horas dejan de
+	// contar, y la alerta se despeja cuando la caída baja de 2 hPa, no de 6.
+	for _, pressure := range []float64{1015, 1013, 1011, 1009, 1009, 1009, 1009} {
+		m.Update(20, 60, pressure)
+		c.Advance(time.Hour)
+	}
+	want := []EventType{EventRaised, EventUpdated, EventUpdated, EventCleared}
+	if got := events.types(); !slices.Equal(got, want) {
+		t.Fatalf("events = %v, want %v", got, want)
+	}
+	if drop := events[0].Alert.Value; drop != 6 {
+		t.Fatalf("drop = %v, want 6", drop)
+	}
+}
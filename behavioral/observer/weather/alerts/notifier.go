@@ -0,0 +1,24 @@
+package alerts
+
+import "fmt"
+
+type Notifier interface {
+	Notify(event Event)
+}
+
+type NotifierFunc func(event Event)
+
+func (f NotifierFunc) Notify(event Event) {
+	f(event)
+}
+
+type ConsoleNotifier struct{}
+
+func NewConsoleNotifier() *ConsoleNotifier {
+	return &ConsoleNotifier{}
+}
+
+func (cn *ConsoleNotifier) Notify(event Event) {
+	fmt.Printf("ALERT %s [%s] %s: %s (%.1f)\n",
+		event.Type, event.Alert.Severity, event.Alert.Rule, event.Alert.Message, event.Alert.Value)
+}
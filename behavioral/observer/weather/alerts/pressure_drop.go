@@ -0,0 +1,48 @@
+package alerts
+
+import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"fmt"
+	"time"
+)
+
+// PressureDrop compara la presión actual con la máxima registrada dentro
+// del periodo indicado.
+type PressureDrop struct {
+	Drop     float64
+	Within   time.Duration
+	ClearAt  float64
+	readings []statistics.Sample
+}
+
+func NewPressureDrop(drop float64, within time.Duration, clearAt float64) *PressureDrop {
+	return &PressureDrop{
+		Drop:    drop,
+		Within:  within,
+		ClearAt: clearAt,
+	}
+}
+
+func (pd *PressureDrop) Observe(reading Reading) Check {
+	pd.readings = append(pd.readings, statistics.Sample{Time: reading.Time, Value: reading.Pressure})
+
+	cutoff := reading.Time.Add(-pd.Within)
+	drop := 0
+	for drop < len(pd.readings) && pd.readings[drop].Time.Before(cutoff) {
+		drop++
+	}
+	pd.readings = pd.readings[drop:]
+
+	highest := reading.Pressure
+	for _, s := range pd.readings {
+		highest = max(highest, s.Value)
+	}
+	fall := highest - reading.Pressure
+
+	return Check{
+		Value:     fall,
+		Triggered: fall >= pd.Drop,
+		Cleared:   fall < pd.ClearAt,
+		Message:   fmt.Sprintf("pressure fell %.1f hPa within %s", fall, pd.Within),
+	}
+}
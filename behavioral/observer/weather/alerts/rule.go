@@ -0,0 +1,55 @@
+package alerts
+
+import "time"
+
+type Reading struct {
+	Time        time.Time
+	Temperature float64
+	Humidity    float64
+	Pressure    float64
+}
+
+type Check struct {
+	Value     float64
+	Triggered bool
+	Cleared   bool
+	Message   string
+}
+
+// Condition decide si una lectura dispara o despeja una alerta. Usar
+// umbrales distintos para disparar y despejar evita que la alerta oscile.
+type Condition interface {
+	Observe(reading Reading) Check
+}
+
+type Rule struct {
+	Name      string
+	Severity  Severity
+	Condition Condition
+	For       time.Duration
+}
+
+func HeatWarning() Rule {
+	return Rule{
+		Name:      "Heat warning",
+		Severity:  SeverityWarning,
+		Condition: NewThreshold(Temperature, Above, 35, 33),
+		For:       10 * time.Minute,
+	}
+}
+
+func FrostWarning() Rule {
+	return Rule{
+		Name:      "Frost warning",
+		Severity:  SeverityWarning,
+		Condition: NewThreshold(Temperature, Below, 0, 2),
+	}
+}
+
+func StormWarning() Rule {
+	return Rule{
+		Name:      "Storm warning",
+		Severity:  SeverityCritical,
+		Condition: NewPressureDrop(6, 3*time.Hour, 2),
+	}
+}
@@ -0,0 +1,62 @@
+package alerts
+
+import "fmt"
+
+type Metric string
+
+const (
+	Temperature Metric = "temperature"
+	Humidity    Metric = "humidity"
+	Pressure    Metric = "pressure"
+)
+
+type Direction int
+
+const (
+	Above Direction = iota
+	Below
+)
+
+type Threshold struct {
+	Metric    Metric
+	Direction Direction
+	RaiseAt   float64
+	ClearAt   float64
+}
+
+func NewThreshold(metric Metric, direction Direction, raiseAt float64, clearAt float64) *Threshold {
+	return &Threshold{
+		Metric:    metric,
+		Direction: direction,
+		RaiseAt:   raiseAt,
+		ClearAt:   clearAt,
+	}
+}
+
+func (t *Threshold) Observe(reading Reading) Check {
+	value := t.valueOf(reading)
+	check := Check{Value: value}
+
+	if t.Direction == Above {
+		check.Triggered = value > t.RaiseAt
+		check.Cleared = value < t.ClearAt
+		check.Message = fmt.Sprintf("%s above %.1f", t.Metric, t.RaiseAt)
+	} else {
+		check.Triggered = value < t.RaiseAt
+		check.Cleared = value > t.ClearAt
+		check.Message = fmt.Sprintf("%s below %.1f", t.Metric, t.RaiseAt)
+	}
+
+	return check
+}
+
+func (t *Threshold) valueOf(reading Reading) float64 {
+	switch t.Metric {
+	case Humidity:
+		return reading.Humidity
+	case Pressure:
+		return reading.Pressure
+	default:
+		return reading.Temperature
+	}
+}
@@ -0,0 +1,126 @@
+package dashboard
+
+import (
+	"designpatterns/behavioral/observer/weather/listeners"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const (
+	clearScreen   = "\033[H\033[2J"
+	defaultWidth  = 90
+	defaultHeight = 8
+)
+
+// Dashboard es un único listener que reparte cada medición entre sus
+// paneles y redibuja una vista de tamaño fijo.
+type Dashboard struct {
+	mu          sync.Mutex
+	out         io.Writer
+	title       string
+	panels      []listeners.Panel
+	width       int
+	height      int
+	interactive bool
+	updates     int
+}
+
+func NewDashboard(out io.Writer, title string, panels ...listeners.Panel) *Dashboard {
+	return &Dashboard{
+		out:         out,
+		title:       title,
+		panels:      panels,
+		width:       defaultWidth,
+		height:      defaultHeight,
+		interactive: IsTerminal(out),
+	}
+}
+
+func (d *Dashboard) SetSize(width int, height int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.width = max(width, 10)
+	d.height = max(height, 4)
+}
+
+// SetInteractive fuerza el modo de refresco; por defecto se detecta según
+// la salida.
+func (d *Dashboard) SetInteractive(interactive bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.interactive = interactive
+}
+
+func (d *Dashboard) AddPanel(p listeners.Panel) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.panels = append(d.panels, p)
+}
+
+func (d *Dashboard) Update(temperature float64, humidity float64, pressure float64) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, panel := range d.panels {
+		panel.Observe(temperature, humidity, pressure)
+	}
+	d.updates++
+	d.display()
+}
+
+func (d *Dashboard) Display() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.display()
+}
+
+func (d *Dashboard) Frame() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.frame()
+}
+
+func (d *Dashboard) display() {
+	if d.interactive {
+		fmt.Fprint(d.out, clearScreen+d.frame())
+		return
+	}
+	fmt.Fprintln(d.out, d.frame())
+}
+
+func (d *Dashboard) frame() string {
+	inner := d.width - 4
+	border := "+" + strings.Repeat("-", d.width-2) + "+\n"
+
+	var lines []string
+	for _, panel := range d.panels {
+		lines = append(lines, strings.Split(panel.Render(), "\n")...)
+	}
+	bodyHeight := max(d.height-4, 0)
+	if len(lines) > bodyHeight {
+		lines = lines[:bodyHeight]
+	}
+	for len(lines) < bodyHeight {
+		lines = append(lines, "")
+	}
+
+	var b strings.Builder
+	b.WriteString(border)
+	b.WriteString(row(fmt.Sprintf("%s (update #%d)", d.title, d.updates), inner))
+	b.WriteString(border)
+	for _, line := range lines {
+		b.WriteString(row(line, inner))
+	}
+	b.WriteString(border)
+	return b.String()
+}
+
+func row(text string, width int) string {
+	if utf8.RuneCountInString(text) > width {
+		runes := []rune(text)
+		text = string(runes[:width-1]) + "…"
+	}
+	return "| " + text + strings.Repeat(" ", width-utf8.RuneCountInString(text)) + " |\n"
+}
@@ -0,0 +1,52 @@
+package dashboard
+
+import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"fmt"
+	"strings"
+)
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+func Sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	low, high := values[0], values[0]
+	for _, v := range values {
+		low = min(low, v)
+		high = max(high, v)
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		index := len(sparkBlocks) / 2
+		if high > low {
+			index = int((v - low) / (high - low) * float64(len(sparkBlocks)-1))
+		}
+		b.WriteRune(sparkBlocks[index])
+	}
+	return b.String()
+}
+
+// SparklinePanel muestra la temperatura de las últimas lecturas.
+type SparklinePanel struct {
+	history *statistics.Window
+}
+
+func NewSparklinePanel(size int) *SparklinePanel {
+	return &SparklinePanel{history: statistics.NewCountWindow(size, nil)}
+}
+
+func (sp *SparklinePanel) Observe(temperature float64, humidity float64, pressure float64) {
+	sp.history.Add(temperature)
+}
+
+func (sp *SparklinePanel) Render() string {
+	values := sp.history.Values()
+	if len(values) == 0 {
+		return "Temperature trend: no readings yet"
+	}
+	return fmt.Sprintf("Temperature trend: %s %.1f°C", Sparkline(values), values[len(values)-1])
+}
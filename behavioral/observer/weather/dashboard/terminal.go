@@ -0,0 +1,20 @@
+package dashboard
+
+import (
+	"io"
+	"os"
+)
+
+// IsTerminal reporta si w es una terminal interactiva; si la salida está
+// redirigida a un archivo o pipe, el dashboard no usa secuencias ANSI.
+func IsTerminal(w io.Writer) bool {
+	file, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,53 @@
+package derived
+
+import (
+	"designpatterns/behavioral/observer/weather/units"
+	"math"
+)
+
+// HeatIndex usa la regresión de Rothfusz del NWS, que trabaja en °F.
+// Por debajo de 80°F se usa la aproximación simple de Steadman.
+func HeatIndex(temperature float64, humidity float64) float64 {
+	t := units.CelsiusToFahrenheit(temperature)
+	rh := humidity
+
+	simple := 0.5 * (t + 61.0 + (t-68.0)*1.2 + rh*0.094)
+	if (simple+t)/2 < 80 {
+		return units.FahrenheitToCelsius(simple)
+	}
+
+	hi := -42.379 + 2.04901523*t + 10.14333127*rh -
+		0.22475541*t*rh - 0.00683783*t*t - 0.05481717*rh*rh +
+		0.00122874*t*t*rh + 0.00085282*t*rh*rh - 0.00000199*t*t*rh*rh
+
+	if rh < 13 && t >= 80 && t <= 112 {
+		hi -= (13 - rh) / 4 * math.Sqrt((17-math.Abs(t-95))/17)
+	} else if rh > 85 && t >= 80 && t <= 87 {
+		hi += (rh - 85) / 10 * (87 - t) / 5
+	}
+
+	return units.FahrenheitToCelsius(hi)
+}
+
+// DewPoint aplica la fórmula de Magnus.
+func DewPoint(temperature float64, humidity float64) float64 {
+	const a, b = 17.62, 243.12
+	gamma := math.Log(math.Max(humidity, 0.01)/100) + a*temperature/(b+temperature)
+	return b * gamma / (a - gamma)
+}
+
+// VaporPressure devuelve la presión parcial de vapor de agua en hPa.
+func VaporPressure(temperature float64, humidity float64) float64 {
+	return humidity / 100 * 6.112 * math.Exp(17.62*temperature/(243.12+temperature))
+}
+
+// ApparentTemperature usa la fórmula de Steadman sin viento ni radiación,
+// ya que la estación no mide esos valores.
+func ApparentTemperature(temperature float64, humidity float64) float64 {
+	return temperature + 0.33*VaporPressure(temperature, humidity) - 4.0
+}
+
+// AbsoluteHumidity devuelve gramos de vapor de agua por metro cúbico.
+func AbsoluteHumidity(temperature float64, humidity float64) float64 {
+	return 216.7 * VaporPressure(temperature, humidity) / (temperature + 273.15)
+}
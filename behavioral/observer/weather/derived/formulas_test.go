@@ -0,0 +1,81 @@
+package derived
+
+import (
+	"designpatterns/behavioral/observer/weather/units"
+	"math"
+	"testing"
+)
+
+func TestHeatIndexMatchesNWSTable(t *testing.T) {
+	// Valores de la tabla del NWS, en °F.
+	tests := []struct {
+		fahrenheit, humidity, want float64
+	}{
+		{90, 70, 106},
+		{96, 65, 121},
+		{86, 90, 105},
+		{100, 10, 95},
+		{70, 50, 69},
+	}
+	for _, tt := range tests {
+		got := units.CelsiusToFahrenheit(HeatIndex(units.FahrenheitToCelsius(tt.fahrenheit), tt.humidity))
+		if math.Abs(got-tt.want) > 1 {
+			t.Errorf("HeatIndex(%v°F, %v%%) = %.1f°F, want about %v°F", tt.fahrenheit, tt.humidity, got, tt.want)
+		}
+	}
+}
+
+func TestDewPoint(t *testing.T) {
+	tests := []struct {
+		temperature, humidity, want float64
+	}{
+		{20, 50, 9.3},
+		{30, 80, 26.2},
+		{10, 100, 10},
+	}
+	for _, tt := range tests {
+		if got := DewPoint(tt.temperature, tt.humidity); math.Abs(got-tt.want) > 0.1 {
+			t.Errorf("DewPoint(%v, %v) = %.2f, want %v", tt.temperature, tt.humidity, got, tt.want)
+		}
+	}
+	if got := DewPoint(20, 0); math.IsInf(got, 0) || math.IsNaN(got) {
+		t.Errorf("DewPoint with 0%% humidity = %v, want a finite value", got)
+	}
+}
+
+func TestVaporAndAbsoluteHumidity(t *testing.T) {
+	// A 20 °C la presión de saturación es de unos 23.4 hPa y el aire
+	// saturado contiene unos 17.3 g/m³.
+	if got := VaporPressure(20, 100); math.Abs(got-23.4) > 0.1 {
+		t.Errorf("VaporPressure = %.2f", got)
+	}
+	if got := AbsoluteHumidity(20, 100); math.Abs(got-17.3) > 0.1 {
+		t.Errorf("AbsoluteHumidity = %.2f", got)
+	}
+}
+
+type metricsRecorder struct {
+	received []Metrics
+}
+
+func (r *metricsRecorder) UpdateMetrics(metrics Metrics) {
+	r.received = append(r.received, metrics)
+}
+
+func TestMetricsCalculatorChainsObservers(t *testing.T) {
+	calculator := NewMetricsCalculator()
+	first, second := &metricsRecorder{}, &metricsRecorder{}
+	calculator.RegisterObserver(first)
+	calculator.RegisterObserver(second)
+
+	calculator.Update(30, 60, 1012)
+	calculator.RemoveObserver(first)
+	calculator.Update(25, 40, 1015)
+
+	if len(first.received) != 1 || len(second.received) != 2 {
+		t.Fatalf("received %d and %d updates", len(first.received), len(second.received))
+	}
+	if got := second.received[1]; got != Compute(25, 40, 1015) || got != calculator.GetMetrics() {
+		t.Fatalf("metrics = %+v", got)
+	}
+}
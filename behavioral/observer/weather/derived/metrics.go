@@ -0,0 +1,25 @@
+package derived
+
+// Metrics siempre se expresa en °C, % y hPa; la conversión de unidades
+// queda a cargo de quien lo muestra.
+type Metrics struct {
+	Temperature         float64
+	Humidity            float64
+	Pressure            float64
+	HeatIndex           float64
+	DewPoint            float64
+	ApparentTemperature float64
+	AbsoluteHumidity    float64
+}
+
+func Compute(temperature float64, humidity float64, pressure float64) Metrics {
+	return Metrics{
+		Temperature:         temperature,
+		Humidity:            humidity,
+		Pressure:            pressure,
+		HeatIndex:           HeatIndex(temperature, humidity),
+		DewPoint:            DewPoint(temperature, humidity),
+		ApparentTemperature: ApparentTemperature(temperature, humidity),
+		AbsoluteHumidity:    AbsoluteHumidity(temperature, humidity),
+	}
+}
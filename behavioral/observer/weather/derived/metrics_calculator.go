@@ -0,0 +1,40 @@
+package derived
+
+// MetricsCalculator escucha a WeatherData y a la vez publica las métricas
+// derivadas, formando una cadena de observers.
+type MetricsCalculator struct {
+	observerList []MetricsListener
+	metrics      Metrics
+}
+
+func NewMetricsCalculator() *MetricsCalculator {
+	return &MetricsCalculator{}
+}
+
+func (mc *MetricsCalculator) Update(temperature float64, humidity float64, pressure float64) {
+	mc.metrics = Compute(temperature, humidity, pressure)
+	mc.NotifyObservers()
+}
+
+func (mc *MetricsCalculator) RegisterObserver(o MetricsListener) {
+	mc.observerList = append(mc.observerList, o)
+}
+
+func (mc *MetricsCalculator) RemoveObserver(o MetricsListener) {
+	for i, observer := range mc.observerList {
+		if observer == o {
+			mc.observerList = append(mc.observerList[:i], mc.observerList[i+1:]...)
+			break
+		}
+	}
+}
+
+func (mc *MetricsCalculator) NotifyObservers() {
+	for _, observer := range mc.observerList {
+		observer.UpdateMetrics(mc.metrics)
+	}
+}
+
+func (mc *MetricsCalculator) GetMetrics() Metrics {
+	return mc.metrics
+}
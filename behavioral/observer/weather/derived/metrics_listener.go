@@ -0,0 +1,11 @@
+package derived
+
+type MetricsListener interface {
+	UpdateMetrics(metrics Metrics)
+}
+
+type MetricsPublisher interface {
+	RegisterObserver(o MetricsListener)
+	RemoveObserver(o MetricsListener)
+	NotifyObservers()
+}
@@ -0,0 +1,19 @@
+package forecast
+
+import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"math"
+)
+
+const fullConfidenceSamples = 6
+
+// confidence combina cuántas muestras hay en la ventana con qué tan bien
+// se ajustan a la recta. Una tendencia estable no tiene ajuste útil, así
+// que solo cuenta la cobertura.
+func confidence(trend statistics.Trend, threshold float64) float64 {
+	coverage := math.Min(1, float64(trend.Samples)/fullConfidenceSamples)
+	if math.Abs(trend.SlopePerHour) < threshold {
+		return coverage * 0.75
+	}
+	return coverage * (0.5 + 0.5*trend.RSquared)
+}
@@ -0,0 +1,66 @@
+package forecast
+
+import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"designpatterns/internal/clock"
+	"time"
+)
+
+// Forecaster acumula presión y humedad para estimar tendencias.
+// Las lecturas a menos de MinInterval de la anterior se descartan:
+// muestras casi simultáneas dispararían la pendiente por hora.
+type Forecaster struct {
+	MinInterval time.Duration
+	clock       clock.Clock
+	pressure    *statistics.Window
+	humidity    *statistics.Window
+	strategy    Strategy
+}
+
+func NewForecaster(window time.Duration, c clock.Clock, strategy Strategy) *Forecaster {
+	if c == nil {
+		c = clock.NewSystemClock()
+	}
+	return &Forecaster{
+		MinInterval: time.Minute,
+		clock:       c,
+		pressure:    statistics.NewTimeWindow(window, c),
+		humidity:    statistics.NewTimeWindow(window, c),
+		strategy:    strategy,
+	}
+}
+
+func (f *Forecaster) SetStrategy(strategy Strategy) {
+	f.strategy = strategy
+}
+
+// Add registra una lectura e indica si se tuvo en cuenta.
+func (f *Forecaster) Add(pressure float64, humidity float64) bool {
+	if latest, err := f.pressure.Latest(); err == nil && f.clock.Now().Sub(latest.Time) < f.MinInterval {
+		return false
+	}
+	f.pressure.Add(pressure)
+	f.humidity.Add(humidity)
+	return true
+}
+
+func (f *Forecaster) Forecast() (Forecast, error) {
+	pressureTrend, err := statistics.LinearTrend(f.pressure.Samples())
+	if err != nil {
+		return Forecast{}, err
+	}
+	humidityTrend, err := statistics.LinearTrend(f.humidity.Samples())
+	if err != nil {
+		return Forecast{}, err
+	}
+
+	latestPressure, _ := f.pressure.Latest()
+	latestHumidity, _ := f.humidity.Latest()
+
+	return f.strategy.Forecast(Conditions{
+		Pressure:      latestPressure.Value,
+		PressureTrend: pressureTrend,
+		Humidity:      latestHumidity.Value,
+		HumidityTrend: humidityTrend,
+	}), nil
+}
@@ -0,0 +1,65 @@
+package forecast
+
+import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"designpatterns/internal/clock"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestForecasterIgnoresReadingsCloserThanMinInterval(t *testing.T) {
+	c := clock.NewFakeClock(time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC))
+	f := NewForecaster(3*time.Hour, c, NewTrendStrategy())
+
+	if !f.Add(1015, 60) {
+		t.Fatal("first reading should be accepted")
+	}
+	c.Advance(time.Millisecond)
+	if f.Add(990, 60) {
+		t.Fatal("reading 1ms later should be ignored")
+	}
+	if _, err := f.Forecast(); err == nil {
+		t.Fatal("expected not enough samples after ignoring the close reading")
+	}
+
+	c.Advance(time.Hour)
+	if !f.Add(1012, 62) {
+		t.Fatal("reading one hour later should be accepted")
+	}
+	result, err := f.Forecast()
+	if err != nil {
+		t.Fatalf("Forecast: %v", err)
+	}
+	if result.Summary == "" {
+		t.Fatal("expected a forecast summary")
+	}
+}
+
+func TestForecasterSlopeUsesElapsedHours(t *testing.T) {
+	tests := []struct {
+		name string
+		step time.Duration
+		want float64
+	}{
+		{"one hour apart", time.Hour, -3},
+		{"half hour apart", 30 * time.Minute, -6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := clock.NewFakeClock(time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC))
+			f := NewForecaster(3*time.Hour, c, NewTrendStrategy())
+			f.Add(1015, 60)
+			c.Advance(tt.step)
+			f.Add(1012, 60)
+
+			trend, err := statistics.LinearTrend(f.pressure.Samples())
+			if err != nil {
+				t.Fatalf("LinearTrend: %v", err)
+			}
+			if math.Abs(trend.SlopePerHour-tt.want) > 1e-9 {
+				t.Fatalf("slope = %v, want %v", trend.SlopePerHour, tt.want)
+			}
+		})
+	}
+}
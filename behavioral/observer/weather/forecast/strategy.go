@@ -0,0 +1,19 @@
+package forecast
+
+import "designpatterns/behavioral/observer/weather/statistics"
+
+type Conditions struct {
+	Pressure      float64
+	PressureTrend statistics.Trend
+	Humidity      float64
+	HumidityTrend statistics.Trend
+}
+
+type Forecast struct {
+	Summary    string
+	Confidence float64
+}
+
+type Strategy interface {
+	Forecast(conditions Conditions) Forecast
+}
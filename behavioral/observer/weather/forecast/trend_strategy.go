@@ -0,0 +1,26 @@
+package forecast
+
+// TrendStrategy reproduce los mensajes clásicos del libro, pero usando
+// la pendiente de la ventana en lugar de solo la lectura anterior.
+type TrendStrategy struct {
+	Threshold float64
+}
+
+func NewTrendStrategy() *TrendStrategy {
+	return &TrendStrategy{Threshold: 0.5}
+}
+
+func (ts *TrendStrategy) Forecast(conditions Conditions) Forecast {
+	trend := conditions.PressureTrend
+	summary := "More of the same"
+	if trend.SlopePerHour >= ts.Threshold {
+		summary = "Improving weather on the way!"
+	} else if trend.SlopePerHour <= -ts.Threshold {
+		summary = "Watch out for cooler, rainy weather"
+	}
+
+	return Forecast{
+		Summary:    summary,
+		Confidence: confidence(trend, ts.Threshold),
+	}
+}
@@ -0,0 +1,79 @@
+package forecast
+
+import "math"
+
+var zambrettiForecasts = map[int]string{
+	1:  "Settled fine",
+	2:  "Fine weather",
+	3:  "Fine, becoming less settled",
+	4:  "Fairly fine, showery later",
+	5:  "Showery, becoming more unsettled",
+	6:  "Unsettled, rain later",
+	7:  "Rain at times, worse later",
+	8:  "Rain at times, becoming very unsettled",
+	9:  "Very unsettled, rain",
+	10: "Settled fine",
+	11: "Fine weather",
+	12: "Fine, possibly showers",
+	13: "Fairly fine, showers likely",
+	14: "Showery, bright intervals",
+	15: "Changeable, some rain",
+	16: "Unsettled, rain at times",
+	17: "Rain at frequent intervals",
+	18: "Very unsettled, rain",
+	19: "Stormy, much rain",
+	20: "Settled fine",
+	21: "Fine weather",
+	22: "Becoming fine",
+	23: "Fairly fine, improving",
+	24: "Fairly fine, possibly showers early",
+	25: "Showery early, improving",
+	26: "Changeable, mending",
+	27: "Rather unsettled, clearing later",
+	28: "Unsettled, probably improving",
+	29: "Unsettled, short fine intervals",
+	30: "Very unsettled, finer at times",
+	31: "Stormy, possibly improving",
+	32: "Stormy, much rain",
+}
+
+// ZambrettiStrategy aplica la versión simplificada del pronosticador de
+// Zambretti: la presión a nivel del mar y su tendencia eligen una fila de
+// la tabla clásica.
+type ZambrettiStrategy struct {
+	Threshold float64
+}
+
+func NewZambrettiStrategy() *ZambrettiStrategy {
+	// 1.6 hPa en 3 horas es el umbral habitual de Zambretti
+	return &ZambrettiStrategy{Threshold: 1.6 / 3}
+}
+
+func (zs *ZambrettiStrategy) Forecast(conditions Conditions) Forecast {
+	pressure := conditions.Pressure
+	slope := conditions.PressureTrend.SlopePerHour
+
+	var z float64
+	var low, high int
+	switch {
+	case slope <= -zs.Threshold:
+		z, low, high = 127-0.12*pressure, 1, 9
+	case slope >= zs.Threshold:
+		z, low, high = 185-0.16*pressure, 20, 32
+	default:
+		z, low, high = 144-0.13*pressure, 10, 19
+	}
+	index := min(max(int(math.Round(z)), low), high)
+
+	result := Forecast{
+		Summary:    zambrettiForecasts[index],
+		Confidence: confidence(conditions.PressureTrend, zs.Threshold),
+	}
+
+	// Humedad alta y subiendo refuerza un pronóstico de lluvia
+	if slope < 0 && conditions.Humidity >= 80 && conditions.HumidityTrend.SlopePerHour > 0 {
+		result.Confidence = math.Min(1, result.Confidence+0.1)
+	}
+
+	return result
+}
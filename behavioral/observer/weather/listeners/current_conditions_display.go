@@ -15,13 +15,21 @@ func NewCurrentConditionsDisplay() *CurrentConditionsDisplay {
 }
 
 func (ccd *CurrentConditionsDisplay) Update(temperature float64, humidity float64, pressure float64) {
+	ccd.Observe(temperature, humidity, pressure)
+	ccd.Display()
+}
+
+func (ccd *CurrentConditionsDisplay) Observe(temperature float64, humidity float64, pressure float64) {
 	ccd.temperature = temperature
 	ccd.humidity = humidity
 	ccd.pressure = pressure
-	ccd.Display()
 }
 
-func (ccd *CurrentConditionsDisplay) Display() {
-	fmt.Printf("Current conditions: %.1f°C and %.1f%% humidity, %.1f pressure\n",
+func (ccd *CurrentConditionsDisplay) Render() string {
+	return fmt.Sprintf("Current conditions: %.1f°C and %.1f%% humidity, %.1f pressure",
 		ccd.temperature, ccd.humidity, ccd.pressure)
 }
+
+func (ccd *CurrentConditionsDisplay) Display() {
+	fmt.Println(ccd.Render())
+}
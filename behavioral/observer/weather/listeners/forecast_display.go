@@ -1,31 +1,48 @@
 package listeners
 
 import (
+	"designpatterns/behavioral/observer/weather/forecast"
+	"designpatterns/internal/clock"
 	"fmt"
+	"time"
 )
 
 type ForecastDisplay struct {
-	currentPressure float64
-	lastPressure    float64
+	forecaster *forecast.Forecaster
 }
 
-func NewForecastDisplay() *ForecastDisplay {
-	return &ForecastDisplay{}
+// NewForecastDisplay exige un reloj: la tendencia se calcula por hora y
+// depende de cuándo llegó cada lectura.
+func NewForecastDisplay(c clock.Clock) *ForecastDisplay {
+	return NewForecastDisplayWithForecaster(
+		forecast.NewForecaster(3*time.Hour, c, forecast.NewZambrettiStrategy()))
+}
+
+func NewForecastDisplayWithForecaster(forecaster *forecast.Forecaster) *ForecastDisplay {
+	return &ForecastDisplay{forecaster: forecaster}
 }
 
 func (fd *ForecastDisplay) Update(temperature float64, humidity float64, pressure float64) {
-	fd.lastPressure = fd.currentPressure
-	fd.currentPressure = pressure
+	fd.Observe(temperature, humidity, pressure)
 	fd.Display()
 }
 
-func (fd *ForecastDisplay) Display() {
-	fmt.Print("Forecast: ")
-	if fd.currentPressure > fd.lastPressure {
-		fmt.Println("Improving weather on the way!")
-	} else if fd.currentPressure == fd.lastPressure {
-		fmt.Println("More of the same")
-	} else if fd.currentPressure < fd.lastPressure {
-		fmt.Println("Watch out for cooler, rainy weather")
+func (fd *ForecastDisplay) Observe(temperature float64, humidity float64, pressure float64) {
+	fd.forecaster.Add(pressure, humidity)
+}
+
+func (fd *ForecastDisplay) Forecast() (forecast.Forecast, error) {
+	return fd.forecaster.Forecast()
+}
+
+func (fd *ForecastDisplay) Render() string {
+	result, err := fd.forecaster.Forecast()
+	if err != nil {
+		return "Forecast: waiting for more pressure readings"
 	}
+	return fmt.Sprintf("Forecast: %s (confidence %.0f%%)", result.Summary, result.Confidence*100)
+}
+
+func (fd *ForecastDisplay) Display() {
+	fmt.Println(fd.Render())
 }
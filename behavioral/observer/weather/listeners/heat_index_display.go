@@ -0,0 +1,35 @@
+package listeners
+
+import (
+	"designpatterns/behavioral/observer/weather/derived"
+	"designpatterns/behavioral/observer/weather/units"
+	"fmt"
+)
+
+type HeatIndexDisplay struct {
+	metrics         derived.Metrics
+	temperatureUnit units.TemperatureUnit
+	pressureUnit    units.PressureUnit
+}
+
+func NewHeatIndexDisplay(temperatureUnit units.TemperatureUnit, pressureUnit units.PressureUnit) *HeatIndexDisplay {
+	return &HeatIndexDisplay{
+		temperatureUnit: temperatureUnit,
+		pressureUnit:    pressureUnit,
+	}
+}
+
+func (hid *HeatIndexDisplay) UpdateMetrics(metrics derived.Metrics) {
+	hid.metrics = metrics
+	hid.Display()
+}
+
+func (hid *HeatIndexDisplay) Display() {
+	t := hid.temperatureUnit
+	fmt.Printf("Heat index %.1f%s, dew point %.1f%s, feels like %.1f%s, %.1f g/m³ at %.2f %s\n",
+		t.FromCelsius(hid.metrics.HeatIndex), t.Symbol(),
+		t.FromCelsius(hid.metrics.DewPoint), t.Symbol(),
+		t.FromCelsius(hid.metrics.ApparentTemperature), t.Symbol(),
+		hid.metrics.AbsoluteHumidity,
+		hid.pressureUnit.FromHectoPascal(hid.metrics.Pressure), hid.pressureUnit.Symbol())
+}
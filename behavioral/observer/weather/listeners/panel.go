@@ -0,0 +1,8 @@
+package listeners
+
+// Panel separa la actualización del estado de su presentación, para que
+// un dashboard pueda componer varias vistas sin que cada una imprima.
+type Panel interface {
+	Observe(temperature float64, humidity float64, pressure float64)
+	Render() string
+}
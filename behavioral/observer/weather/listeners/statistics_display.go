@@ -1,40 +1,82 @@
 package listeners
 
 import (
+	"designpatterns/behavioral/observer/weather/statistics"
+	"designpatterns/internal/clock"
 	"fmt"
+	"strings"
+	"time"
 )
 
 type StatisticsDisplay struct {
-	maxTemp     float64
-	minTemp     float64
-	tempSum     float64
-	numReadings int
+	label string
+	stats *statistics.Set
 }
 
 func NewStatisticsDisplay() *StatisticsDisplay {
 	return &StatisticsDisplay{
-		maxTemp: -999.0,
-		minTemp: 999.0,
+		stats: statistics.NewSet(func() *statistics.Window {
+			return statistics.NewUnboundedWindow(nil)
+		}),
+	}
+}
+
+func NewTimeWindowStatisticsDisplay(label string, maxAge time.Duration, c clock.Clock) *StatisticsDisplay {
+	return &StatisticsDisplay{
+		label: label,
+		stats: statistics.NewSet(func() *statistics.Window {
+			return statistics.NewTimeWindow(maxAge, c)
+		}),
+	}
+}
+
+func NewCountWindowStatisticsDisplay(label string, size int) *StatisticsDisplay {
+	return &StatisticsDisplay{
+		label: label,
+		stats: statistics.NewSet(func() *statistics.Window {
+			return statistics.NewCountWindow(size, nil)
+		}),
 	}
 }
 
 func (sd *StatisticsDisplay) Update(temperature float64, humidity float64, pressure float64) {
-	sd.tempSum += temperature
-	sd.numReadings++
+	sd.Observe(temperature, humidity, pressure)
+	sd.Display()
+}
+
+func (sd *StatisticsDisplay) Observe(temperature float64, humidity float64, pressure float64) {
+	sd.stats.Add(temperature, humidity, pressure)
+}
+
+func (sd *StatisticsDisplay) Statistics() *statistics.Set {
+	return sd.stats
+}
 
-	if temperature > sd.maxTemp {
-		sd.maxTemp = temperature
+// Render muestra una línea por magnitud: temperatura, humedad y presión.
+func (sd *StatisticsDisplay) Render() string {
+	prefix := ""
+	if sd.label != "" {
+		prefix = "[" + sd.label + "] "
 	}
 
-	if temperature < sd.minTemp {
-		sd.minTemp = temperature
+	lines := []string{
+		renderSummary(prefix, "temperature", sd.stats.Temperature),
+		renderSummary(prefix, "humidity", sd.stats.Humidity),
+		renderSummary(prefix, "pressure", sd.stats.Pressure),
 	}
+	return strings.Join(lines, "\n")
+}
 
-	sd.Display()
+func renderSummary(prefix string, name string, window *statistics.Window) string {
+	summary, err := window.Summary()
+	if err != nil {
+		return prefix + "Avg/Max/Min " + name + " = no readings yet"
+	}
+
+	return fmt.Sprintf("%sAvg/Max/Min %s = %.1f/%.1f/%.1f (median %.1f, stddev %.2f, n=%d)",
+		prefix, name, summary.Mean, summary.Max, summary.Min, summary.Median, summary.StdDev, summary.Count)
 }
 
 func (sd *StatisticsDisplay) Display() {
-	avgTemp := sd.tempSum / float64(sd.numReadings)
-	fmt.Printf("Avg/Max/Min temperature = %.1f/%.1f/%.1f\n",
-		avgTemp, sd.maxTemp, sd.minTemp)
+	fmt.Println(sd.Render())
 }
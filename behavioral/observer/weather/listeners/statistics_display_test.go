@@ -0,0 +1,23 @@
+package listeners
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStatisticsDisplayRendersEveryMagnitude(t *testing.T) {
+	sd := NewCountWindowStatisticsDisplay("3", 3)
+	if got := sd.Render(); strings.Count(got, "no readings yet") != 3 {
+		t.Fatalf("empty Render = %q, want three empty summaries", got)
+	}
+
+	sd.Observe(20, 60, 1012)
+	sd.Observe(22, 70, 1014)
+
+	want := "[3] Avg/Max/Min temperature = 21.0/22.0/20.0 (median 21.0, stddev 1.00, n=2)\n" +
+		"[3] Avg/Max/Min humidity = 65.0/70.0/60.0 (median 65.0, stddev 5.00, n=2)\n" +
+		"[3] Avg/Max/Min pressure = 1013.0/1014.0/1012.0 (median 1013.0, stddev 1.00, n=2)"
+	if got := sd.Render(); got != want {
+		t.Fatalf("Render =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -1,13 +1,39 @@
 package main
 
 import (
+	"context"
+	"designpatterns/behavioral/observer/eventbus"
+	"designpatterns/behavioral/observer/weather/alerts"
+	"designpatterns/behavioral/observer/weather/dashboard"
+	"designpatterns/behavioral/observer/weather/derived"
+	"designpatterns/behavioral/observer/weather/forecast"
 	"designpatterns/behavioral/observer/weather/listeners"
+	"designpatterns/behavioral/observer/weather/measurement"
 	"designpatterns/behavioral/observer/weather/publisher"
+	"designpatterns/behavioral/observer/weather/sensors"
+	"designpatterns/behavioral/observer/weather/stations"
+	"designpatterns/behavioral/observer/weather/storage"
+	"designpatterns/behavioral/observer/weather/units"
+	"designpatterns/behavioral/observer/weather/validation"
+	"designpatterns/internal/clock"
+	"flag"
 	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
 	"strings"
+	"time"
 )
 
 func main() {
+	dashboardMode := flag.Bool("dashboard", false, "muestra un dashboard en vivo alimentado por un sensor simulado")
+	flag.Parse()
+	if *dashboardMode {
+		runDashboard()
+		return
+	}
+
 	fmt.Println("=== Weather Station - Observer Pattern Demo ===")
 
 	// Una sola instancia
@@ -18,28 +44,264 @@ func main() {
 
 	currentDisplay := listeners.NewCurrentConditionsDisplay()
 	statisticsDisplay := listeners.NewStatisticsDisplay()
-	forecastDisplay := listeners.NewForecastDisplay()
+
+	// Ventana deslizante de 24h controlada por un reloj simulado
+	stationClock := clock.NewFakeClock(time.Date(2024, 7, 1, 6, 0, 0, 0, time.UTC))
+	dailyStatisticsDisplay := listeners.NewTimeWindowStatisticsDisplay("24h", 24*time.Hour, stationClock)
+
+	// El pronóstico usa la tendencia de presión de las últimas 3 horas
+	forecastDisplay := listeners.NewForecastDisplayWithForecaster(
+		forecast.NewForecaster(3*time.Hour, stationClock, forecast.NewZambrettiStrategy()))
+
+	// Cadena de observers: WeatherData -> MetricsCalculator -> HeatIndexDisplay
+	metricsCalculator := derived.NewMetricsCalculator()
+	heatIndexDisplay := listeners.NewHeatIndexDisplay(units.Fahrenheit, units.InchesOfMercury)
+	metricsCalculator.RegisterObserver(heatIndexDisplay)
 
 	weatherPublisher.RegisterObserver(currentDisplay)
 	weatherPublisher.RegisterObserver(statisticsDisplay)
+	weatherPublisher.RegisterObserver(dailyStatisticsDisplay)
 	weatherPublisher.RegisterObserver(forecastDisplay)
+	weatherPublisher.RegisterObserver(metricsCalculator)
 
 	fmt.Println("\n1. Primera medición:")
 	fmt.Println(strings.Repeat("-", 40))
-	weatherData.SetMeasurements(26.6, 65.0, 1013.1)
+	measure(weatherData, 26.6, 65.0, 1013.1)
 
 	fmt.Println("\n2. Segunda medición:")
 	fmt.Println(strings.Repeat("-", 40))
-	weatherData.SetMeasurements(27.7, 70.0, 997.0)
+	stationClock.Advance(time.Hour)
+	measure(weatherData, 27.7, 70.0, 997.0)
 
 	fmt.Println("\n3. Tercera medición:")
 	fmt.Println(strings.Repeat("-", 40))
-	weatherData.SetMeasurements(25.5, 90.0, 1005.0)
+	stationClock.Advance(time.Hour)
+	measure(weatherData, 25.5, 90.0, 1005.0)
 
 	fmt.Println("\n4. Removiendo el forecast display usando la interfaz:")
 	fmt.Println(strings.Repeat("-", 40))
 	weatherPublisher.RemoveObserver(forecastDisplay)
-	weatherData.SetMeasurements(24.2, 85.0, 1008.5)
+	stationClock.Advance(23 * time.Hour)
+	measure(weatherData, 24.2, 85.0, 1008.5)
+
+	fmt.Println("\n5. Alertas con histéresis (ola de calor):")
+	fmt.Println(strings.Repeat("-", 40))
+	weatherPublisher.RemoveObserver(statisticsDisplay)
+	weatherPublisher.RemoveObserver(dailyStatisticsDisplay)
+
+	alertMonitor := alerts.NewMonitor(stationClock,
+		alerts.HeatWarning(), alerts.StormWarning(), alerts.FrostWarning())
+	alertMonitor.AddNotifier(alerts.NewConsoleNotifier())
+	weatherPublisher.RegisterObserver(alertMonitor)
+
+	// Grabar la ola de calor para reproducirla después
+	recordingPath := filepath.Join(os.TempDir(), "weather-station-demo.jsonl")
+	os.Remove(recordingPath)
+	recorder, err := storage.NewFileRecorder(recordingPath, stationClock)
+	if err != nil {
+		fmt.Println("Error:", err)
+		return
+	}
+	weatherPublisher.RegisterObserver(recorder)
+
+	measure(weatherData, 35.8, 40.0, 1009.0)
+	stationClock.Advance(10 * time.Minute)
+	measure(weatherData, 36.4, 38.0, 1008.0)
+	stationClock.Advance(10 * time.Minute)
+	measure(weatherData, 34.0, 42.0, 1006.0) // entre umbrales: sigue activa
+	stationClock.Advance(10 * time.Minute)
+	measure(weatherData, 32.5, 50.0, 1001.5)
+
+	recorder.Close()
+
+	fmt.Println("\n6. Reproduciendo la grabación en otra estación:")
+	fmt.Println(strings.Repeat("-", 40))
+	replayStation := publisher.NewWeatherData()
+	replayStation.RegisterObserver(listeners.NewCurrentConditionsDisplay())
+	replayed, err := storage.ReplayFile(context.Background(), recordingPath, replayStation, 0)
+	if err != nil {
+		fmt.Println("Error:", err)
+	}
+	fmt.Printf("%d mediciones reproducidas desde %s\n", replayed, recordingPath)
+
+	fmt.Println("\n7. Varias estaciones con un publisher agregador:")
+	fmt.Println(strings.Repeat("-", 40))
+	aggregator := stations.NewAggregator(stations.NewRegistry())
+	sharedBus := eventbus.NewBus[measurement.Measurement]()
+	bogota := publisher.NewWeatherStationOnBus("BOG-01", publisher.Location{Name: "Bogotá", Region: "Andina"}, sharedBus)
+	medellin := publisher.NewWeatherStationOnBus("MDE-01", publisher.Location{Name: "Medellín", Region: "Andina"}, sharedBus)
+	cartagena := publisher.NewWeatherStationOnBus("CTG-01", publisher.Location{Name: "Cartagena", Region: "Caribe"}, sharedBus)
+	for _, station := range []*publisher.WeatherData{bogota, medellin, cartagena} {
+		if err := aggregator.Track(station); err != nil {
+			fmt.Println("Error:", err)
+		}
+	}
+
+	caribe := aggregator.SubscribeRegion("Caribe", stations.StationListenerFunc(func(r stations.Reading) {
+		fmt.Printf("[Caribe] %s: %.1f°C\n", r.Location.Name, r.Temperature)
+	}))
+
+	// Suscriptor asíncrono con comodín sobre el bus compartido
+	busEvents := 0
+	counter := sharedBus.Subscribe(publisher.MeasurementsTopic+".*", eventbus.SubscriberFunc[measurement.Measurement](
+		func(event eventbus.Event[measurement.Measurement]) error {
+			busEvents++
+			return nil
+		}), eventbus.WithAsync[measurement.Measurement](16))
+
+	measure(bogota, 14.5, 80.0, 1027.0)
+	measure(medellin, 23.0, 70.0, 1015.0)
+	measure(cartagena, 31.2, 85.0, 1010.0)
+
+	if andina, err := aggregator.RegionalAverage("Andina"); err == nil {
+		fmt.Printf("Promedio región Andina (%d estaciones): %.1f°C\n", andina.Stations, andina.Temperature)
+	}
+	if hottest, err := aggregator.HottestStation(); err == nil {
+		fmt.Printf("Estación más caliente: %s (%.1f°C)\n", hottest.Location.Name, hottest.Temperature)
+	}
+
+	aggregator.Unsubscribe(caribe)
+
+	sharedBus.Close()
+	counter.Wait()
+	fmt.Printf("Eventos recibidos por el suscriptor asíncrono: %d\n", busEvents)
+
+	fmt.Println("\n8. Alimentando WeatherData desde adaptadores de entrada:")
+	fmt.Println(strings.Repeat("-", 40))
+	sensorStation := publisher.NewWeatherData()
+	sensorStation.RegisterObserver(listeners.NewCurrentConditionsDisplay())
+
+	csvSource := sensors.NewCSVSource(strings.NewReader(
+		"temperature,humidity,pressure\n21.0,60,1012\n21.4,sesenta,1012\n21.9,58,1011.5\n"))
+	csvSource.SetErrorHandler(func(err error) {
+		fmt.Println("Línea descartada:", err)
+	})
+	if err := csvSource.Run(context.Background(), sensorStation); err != nil {
+		fmt.Println("Error:", err)
+	}
+
+	simulated := sensors.NewSimulatedSensor(22.0, 55.0, 1010.0, 42)
+	simulated.Interval = 0
+	simulated.Count = 2
+	simulated.PressureDrift = -1.5
+	if err := simulated.Run(context.Background(), sensorStation); err != nil {
+		fmt.Println("Error:", err)
+	}
+
+	fmt.Println("\n9. Validación y rechazo de lecturas anómalas:")
+	fmt.Println(strings.Repeat("-", 40))
+	validatedClock := clock.NewFakeClock(time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC))
+	validatedStation := publisher.NewWeatherStation("VAL-01", publisher.Location{Name: "Laboratorio"})
+	validatedStation.SetClock(validatedClock)
+	validatedStation.SetValidator(validation.NewChain(
+		validation.NewPhysicalRangeValidator(),
+		validation.NewRateOfChangeValidator(),
+		validation.NewSpikeFilter(3, 5, 20, 5),
+	))
+	faultBus := eventbus.NewBus[validation.Fault]()
+	faultBus.Subscribe(publisher.FaultsTopic+".#", eventbus.SubscriberFunc[validation.Fault](
+		func(event eventbus.Event[validation.Fault]) error {
+			fmt.Printf("Sensor fault en %s: %v\n", event.Payload.StationID, event.Payload.Err)
+			return nil
+		}))
+	validatedStation.SetFaultPublisher(faultBus)
+	validatedStation.RegisterObserver(listeners.NewStatisticsDisplay())
+
+	readings := [][3]float64{
+		{20.0, 55, 1012},
+		{20.4, 56, 1012},
+		{math.NaN(), 56, 1012},
+		{20.6, -5, 1012},
+		{20.9, 57, 1011.8},
+		{45.0, 57, 1011.8},
+		{21.1, 58, 1011.5},
+	}
+	rejected := 0
+	for _, r := range readings {
+		validatedClock.Advance(10 * time.Minute)
+		if err := validatedStation.SetMeasurements(r[0], r[1], r[2]); err != nil {
+			rejected++
+		}
+	}
+	fmt.Printf("Lecturas rechazadas: %d de %d\n", rejected, len(readings))
+
+	fmt.Println("\n10. Dashboard de texto (último cuadro):")
+	fmt.Println(strings.Repeat("-", 40))
+	dashboardClock := clock.NewFakeClock(time.Date(2024, 7, 4, 9, 0, 0, 0, time.UTC))
+	dashboardStation := publisher.NewWeatherData()
+	board := newDashboard(io.Discard, dashboardClock)
+	dashboardStation.RegisterObserver(board)
+	demoSensor := sensors.NewSimulatedSensor(18.0, 70.0, 1016.0, 7)
+	demoSensor.Interval = 0
+	demoSensor.Count = 24
+	demoSensor.TemperatureDrift = 0.25
+	demoSensor.PressureDrift = 0.1
+	if err := demoSensor.Run(context.Background(), &tickingSink{clock: dashboardClock, step: 5 * time.Minute, sink: dashboardStation}); err != nil {
+		fmt.Println("Error:", err)
+	}
+	fmt.Print(board.Frame())
 
 	fmt.Println("\n=== Demo completado ===")
 }
+
+// measure informa las lecturas que la estación rechaza al validar.
+func measure(station *publisher.WeatherData, tmp float64, hum float64, pre float64) {
+	if err := station.SetMeasurements(tmp, hum, pre); err != nil {
+		fmt.Println("Lectura rechazada:", err)
+	}
+}
+
+// dashboardStep es cuánto avanza el reloj simulado del dashboard por
+// lectura; con menos de un minuto el pronóstico descartaría cada muestra.
+const dashboardStep = 5 * time.Minute
+
+func newDashboard(out io.Writer, c clock.Clock) *dashboard.Dashboard {
+	board := dashboard.NewDashboard(out, "Weather Station",
+		listeners.NewCurrentConditionsDisplay(),
+		listeners.NewTimeWindowStatisticsDisplay("1h", time.Hour, c),
+		listeners.NewForecastDisplayWithForecaster(
+			forecast.NewForecaster(3*time.Hour, c, forecast.NewTrendStrategy())),
+		dashboard.NewSparklinePanel(40),
+	)
+	// Una línea por panel y tres para las estadísticas, más los bordes
+	board.SetSize(90, 10)
+	return board
+}
+
+// runDashboard refresca la vista en la terminal; si la salida no es una
+// terminal imprime cada cuadro como texto plano. Las lecturas llegan cada
+// medio segundo, pero cada una representa dashboardStep de tiempo simulado.
+func runDashboard() {
+	sensor := sensors.NewSimulatedSensor(18.0, 70.0, 1016.0, time.Now().UnixNano())
+	sensor.Interval = 500 * time.Millisecond
+	sensor.Count = 60
+	if err := playDashboard(context.Background(), os.Stdout, sensor); err != nil {
+		fmt.Println("Error:", err)
+	}
+}
+
+// playDashboard alimenta un dashboard con el sensor y avanza un reloj
+// simulado dashboardStep antes de cada lectura.
+func playDashboard(ctx context.Context, out io.Writer, sensor *sensors.SimulatedSensor) error {
+	c := clock.NewFakeClock(time.Date(2024, 7, 4, 9, 0, 0, 0, time.UTC))
+	station := publisher.NewWeatherData()
+	station.SetClock(c)
+	station.RegisterObserver(newDashboard(out, c))
+
+	sensor.TemperatureDrift = 0.1
+	sensor.PressureDrift = -0.2
+	return sensor.Run(ctx, &tickingSink{clock: c, step: dashboardStep, sink: station})
+}
+
+// tickingSink avanza el reloj simulado antes de cada lectura, para que
+// las ventanas por tiempo vean mediciones espaciadas.
+type tickingSink struct {
+	clock *clock.FakeClock
+	step  time.Duration
+	sink  sensors.Sink
+}
+
+func (ts *tickingSink) SetMeasurements(tmp float64, hum float64, pre float64) error {
+	ts.clock.Advance(ts.step)
+	return ts.sink.SetMeasurements(tmp, hum, pre)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"designpatterns/behavioral/observer/weather/sensors"
+	"strings"
+	"testing"
+)
+
+func TestPlayDashboardShowsForecast(t *testing.T) {
+	sensor := sensors.NewSimulatedSensor(18.0, 70.0, 1016.0, 7)
+	sensor.Interval = 0
+	sensor.Count = 12
+	var out bytes.Buffer
+
+	if err := playDashboard(context.Background(), &out, sensor); err != nil {
+		t.Fatalf("playDashboard: %v", err)
+	}
+
+	frames := strings.Split(strings.TrimSpace(out.String()), "\n\n")
+	if len(frames) != 12 {
+		t.Fatalf("got %d frames, want 12", len(frames))
+	}
+	if !strings.Contains(frames[0], "Forecast: waiting for more pressure readings") {
+		t.Fatalf("first frame should wait for more readings:\n%s", frames[0])
+	}
+	last := frames[len(frames)-1]
+	for _, want := range []string{"update #12", "Avg/Max/Min pressure", "Forecast: ", "Temperature trend: "} {
+		if !strings.Contains(last, want) {
+			t.Fatalf("last frame lacks %q:\n%s", want, last)
+		}
+	}
+	if strings.Contains(last, "waiting") {
+		t.Fatalf("last frame still has no forecast:\n%s", last)
+	}
+}
@@ -0,0 +1,19 @@
+package measurement
+
+import "time"
+
+type Measurement struct {
+	Time        time.Time `json:"time"`
+	Temperature float64   `json:"temperature"`
+	Humidity    float64   `json:"humidity"`
+	Pressure    float64   `json:"pressure"`
+}
+
+func New(t time.Time, temperature float64, humidity float64, pressure float64) Measurement {
+	return Measurement{
+		Time:        t,
+		Temperature: temperature,
+		Humidity:    humidity,
+		Pressure:    pressure,
+	}
+}
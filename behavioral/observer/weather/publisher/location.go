@@ -0,0 +1,8 @@
+package publisher
+
+type Location struct {
+	Name      string
+	Region    string
+	Latitude  float64
+	Longitude float64
+}
@@ -1,57 +1,205 @@
 package publisher
 
 import (
+	"designpatterns/behavioral/observer/eventbus"
 	"designpatterns/behavioral/observer/weather/listeners"
+	"designpatterns/behavioral/observer/weather/measurement"
+	"designpatterns/behavioral/observer/weather/validation"
+	"designpatterns/internal/clock"
+	"fmt"
+	"reflect"
+	"sync/atomic"
 )
 
+const (
+	MeasurementsTopic = "weather.measurements"
+	FaultsTopic       = "weather.faults"
+)
+
+// anonymousStations numera las estaciones sin ID para que cada una
+// publique en su propio tópico aunque compartan bus.
+var anonymousStations atomic.Uint64
+
+// ObserverID identifica un listener registrado; permite quitar listeners
+// que no se pueden comparar, como las funciones.
+type ObserverID uint64
+
+type observer struct {
+	id           ObserverID
+	listener     listeners.WeatherListener
+	subscription *eventbus.Subscription[measurement.Measurement]
+}
+
+// WeatherData publica cada medición en un eventbus.Bus; los
+// WeatherListener clásicos se registran como suscriptores de ese bus.
 type WeatherData struct {
-	observerList []listeners.WeatherListener
-	temperature  float64
-	humidity     float64
-	pressure     float64
+	id        string
+	key       string
+	location  Location
+	bus       *eventbus.Bus[measurement.Measurement]
+	clock     clock.Clock
+	observers []observer
+	nextID    ObserverID
+	validator validation.Validator
+	faults    eventbus.Publisher[validation.Fault]
+	current   measurement.Measurement
 }
 
 func NewWeatherData() *WeatherData {
-	return &WeatherData{}
+	return NewWeatherStation("", Location{})
+}
+
+func NewWeatherStation(id string, location Location) *WeatherData {
+	return NewWeatherStationOnBus(id, location, eventbus.NewBus[measurement.Measurement]())
+}
+
+// NewWeatherStationOnBus permite que varias estaciones compartan un bus,
+// de modo que un suscriptor a "weather.measurements.*" las reciba todas.
+func NewWeatherStationOnBus(id string, location Location, bus *eventbus.Bus[measurement.Measurement]) *WeatherData {
+	key := id
+	if key == "" {
+		key = fmt.Sprintf("anonymous-%d", anonymousStations.Add(1))
+	}
+	return &WeatherData{
+		id:        id,
+		key:       key,
+		location:  location,
+		bus:       bus,
+		clock:     clock.NewSystemClock(),
+		validator: validation.NewPhysicalRangeValidator(),
+	}
+}
+
+func (wd *WeatherData) GetID() string {
+	return wd.id
+}
+
+func (wd *WeatherData) GetLocation() Location {
+	return wd.location
+}
+
+func (wd *WeatherData) Topic() string {
+	return MeasurementsTopic + "." + wd.key
+}
+
+func (wd *WeatherData) FaultTopic() string {
+	return FaultsTopic + "." + wd.key
+}
+
+func (wd *WeatherData) Events() *eventbus.Bus[measurement.Measurement] {
+	return wd.bus
 }
 
+func (wd *WeatherData) SetClock(c clock.Clock) {
+	wd.clock = c
+}
+
+// SetValidator reemplaza la validación de cada lectura antes de notificar.
+// Por defecto se rechazan los valores no finitos o fuera de los rangos
+// físicos; desactivarla exige pasar nil explícitamente.
+func (wd *WeatherData) SetValidator(v validation.Validator) {
+	wd.validator = v
+}
+
+// SetFaultPublisher habilita la publicación de lecturas rechazadas en
+// FaultTopic.
+func (wd *WeatherData) SetFaultPublisher(p eventbus.Publisher[validation.Fault]) {
+	wd.faults = p
+}
+
+// RegisterObserver ignora un listener que ya está registrado. Los
+// listeners no comparables se registran siempre; para quitarlos hay que
+// usar Subscribe y Unsubscribe.
 func (wd *WeatherData) RegisterObserver(o listeners.WeatherListener) {
-	wd.observerList = append(wd.observerList, o)
+	if _, exists := wd.find(o); exists {
+		return
+	}
+	wd.Subscribe(o)
 }
 
 func (wd *WeatherData) RemoveObserver(o listeners.WeatherListener) {
-	for i, observer := range wd.observerList {
-		if observer == o {
-			wd.observerList = append(wd.observerList[:i], wd.observerList[i+1:]...)
-			break
+	if i, exists := wd.find(o); exists {
+		wd.Unsubscribe(wd.observers[i].id)
+	}
+}
+
+func (wd *WeatherData) Subscribe(o listeners.WeatherListener) ObserverID {
+	wd.nextID++
+	wd.observers = append(wd.observers, observer{
+		id:       wd.nextID,
+		listener: o,
+		subscription: wd.bus.Subscribe(wd.Topic(), eventbus.SubscriberFunc[measurement.Measurement](
+			func(event eventbus.Event[measurement.Measurement]) error {
+				m := event.Payload
+				o.Update(m.Temperature, m.Humidity, m.Pressure)
+				return nil
+			})),
+	})
+	return wd.nextID
+}
+
+// Unsubscribe quita el listener e indica si estaba registrado.
+func (wd *WeatherData) Unsubscribe(id ObserverID) bool {
+	for i, o := range wd.observers {
+		if o.id == id {
+			wd.observers = append(wd.observers[:i], wd.observers[i+1:]...)
+			wd.bus.Unsubscribe(o.subscription)
+			return true
 		}
 	}
+	return false
+}
 
+func (wd *WeatherData) find(o listeners.WeatherListener) (int, bool) {
+	if o == nil || !reflect.TypeOf(o).Comparable() {
+		return 0, false
+	}
+	for i, registered := range wd.observers {
+		if reflect.TypeOf(registered.listener).Comparable() && registered.listener == o {
+			return i, true
+		}
+	}
+	return 0, false
 }
 
+// NotifyObservers publica la última medición aceptada, con la hora en que
+// se tomó.
 func (wd *WeatherData) NotifyObservers() {
-	for _, observer := range wd.observerList {
-		observer.Update(wd.temperature, wd.humidity, wd.pressure)
-	}
+	wd.bus.Publish(wd.Topic(), wd.current)
 }
 
-func (wd *WeatherData) SetMeasurements(tmp float64, hum float64, pre float64) {
-	wd.temperature = tmp
-	wd.humidity = hum
-	wd.pressure = pre
+func (wd *WeatherData) SetMeasurements(tmp float64, hum float64, pre float64) error {
+	m := measurement.New(wd.clock.Now(), tmp, hum, pre)
+	if wd.validator != nil {
+		if err := wd.validator.Validate(m); err != nil {
+			if observer, ok := wd.validator.(validation.RejectObserver); ok {
+				observer.Rejected(m, err)
+			}
+			if wd.faults != nil {
+				wd.faults.Publish(wd.FaultTopic(), validation.Fault{StationID: wd.id, Measurement: m, Err: err})
+			}
+			return err
+		}
+		if observer, ok := wd.validator.(validation.AcceptObserver); ok {
+			observer.Accepted(m)
+		}
+	}
+
+	wd.current = m
 	wd.MeasurementsChanged()
+	return nil
 }
 
 func (wd *WeatherData) GetTemperature() float64 {
-	return wd.temperature
+	return wd.current.Temperature
 }
 
 func (wd *WeatherData) GetHumidity() float64 {
-	return wd.humidity
+	return wd.current.Humidity
 }
 
 func (wd *WeatherData) GetPressure() float64 {
-	return wd.pressure
+	return wd.current.Pressure
 }
 
 func (wd *WeatherData) MeasurementsChanged() {
@@ -0,0 +1,145 @@
+package publisher
+
+import (
+	"designpatterns/behavioral/observer/eventbus"
+	"designpatterns/behavioral/observer/weather/measurement"
+	"designpatterns/behavioral/observer/weather/validation"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+type listenerFunc func(temperature float64, humidity float64, pressure float64)
+
+func (f listenerFunc) Update(temperature float64, humidity float64, pressure float64) {
+	f(temperature, humidity, pressure)
+}
+
+type countingListener struct {
+	calls int
+}
+
+func (cl *countingListener) Update(float64, float64, float64) {
+	cl.calls++
+}
+
+func TestFuncListenerCanBeRegisteredAndRemoved(t *testing.T) {
+	wd := NewWeatherData()
+	calls := 0
+	f := listenerFunc(func(float64, float64, float64) { calls++ })
+
+	wd.RegisterObserver(f)
+	id := wd.Subscribe(f)
+	wd.RemoveObserver(f) // no comparable: no hace nada y no entra en pánico
+	wd.SetMeasurements(20, 60, 1013)
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+
+	if !wd.Unsubscribe(id) {
+		t.Fatal("Unsubscribe should report a registered listener")
+	}
+	wd.SetMeasurements(21, 60, 1013)
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3", calls)
+	}
+}
+
+func TestRegisterObserverIgnoresDuplicates(t *testing.T) {
+	wd := NewWeatherData()
+	listener := &countingListener{}
+	wd.RegisterObserver(listener)
+	wd.RegisterObserver(listener)
+
+	wd.SetMeasurements(20, 60, 1013)
+	if listener.calls != 1 {
+		t.Fatalf("calls = %d, want 1", listener.calls)
+	}
+
+	wd.RemoveObserver(listener)
+	wd.SetMeasurements(21, 60, 1013)
+	if listener.calls != 1 {
+		t.Fatalf("calls = %d after RemoveObserver, want 1", listener.calls)
+	}
+}
+
+func TestAnonymousStationsOnSharedBusAreIsolated(t *testing.T) {
+	bus := eventbus.NewBus[measurement.Measurement]()
+	first := NewWeatherStationOnBus("", Location{}, bus)
+	second := NewWeatherStationOnBus("", Location{}, bus)
+	if first.Topic() == second.Topic() {
+		t.Fatalf("both stations publish to %q", first.Topic())
+	}
+
+	listener := &countingListener{}
+	first.RegisterObserver(listener)
+	second.SetMeasurements(20, 60, 1013)
+	if listener.calls != 0 {
+		t.Fatalf("first station received %d readings from the second", listener.calls)
+	}
+}
+
+func TestDefaultValidatorRejectsImpossibleReadings(t *testing.T) {
+	wd := NewWeatherData()
+	listener := &countingListener{}
+	wd.RegisterObserver(listener)
+
+	for _, reading := range [][3]float64{{20, 150, 1013}, {math.NaN(), 60, 1013}, {20, 60, math.Inf(1)}} {
+		err := wd.SetMeasurements(reading[0], reading[1], reading[2])
+		if !errors.Is(err, validation.ErrRejected) {
+			t.Fatalf("SetMeasurements(%v) = %v, want ErrRejected", reading, err)
+		}
+	}
+	if listener.calls != 0 {
+		t.Fatalf("calls = %d, want 0: rejected readings must not be published", listener.calls)
+	}
+
+	wd.SetValidator(nil)
+	if err := wd.SetMeasurements(20, 150, 1013); err != nil {
+		t.Fatalf("SetMeasurements with validation disabled: %v", err)
+	}
+	if listener.calls != 1 {
+		t.Fatalf("calls = %d, want 1", listener.calls)
+	}
+}
+
+// tickingClock avanza un segundo en cada lectura, para detectar si una
+// medición consulta la hora más de una vez.
+type tickingClock struct {
+	now time.Time
+}
+
+func (tc *tickingClock) Now() time.Time {
+	tc.now = tc.now.Add(time.Second)
+	return tc.now
+}
+
+func TestPublishedMeasurementIsTheValidatedOne(t *testing.T) {
+	wd := NewWeatherData()
+	start := time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC)
+	wd.SetClock(&tickingClock{now: start})
+
+	var validated, published []time.Time
+	wd.SetValidator(validatorFunc(func(m measurement.Measurement) error {
+		validated = append(validated, m.Time)
+		return nil
+	}))
+	wd.Events().Subscribe(wd.Topic(), eventbus.SubscriberFunc[measurement.Measurement](
+		func(event eventbus.Event[measurement.Measurement]) error {
+			published = append(published, event.Payload.Time)
+			return nil
+		}))
+
+	wd.SetMeasurements(20, 60, 1013)
+	want := start.Add(time.Second)
+	if len(validated) != 1 || len(published) != 1 || !validated[0].Equal(want) || !published[0].Equal(want) {
+		t.Fatalf("validated %v, published %v, want both at %v", validated, published, want)
+	}
+}
+
+type validatorFunc func(m measurement.Measurement) error
+
+func (f validatorFunc) Validate(m measurement.Measurement) error {
+	return f(m)
+}
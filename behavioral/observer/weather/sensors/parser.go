@@ -0,0 +1,80 @@
+package sensors
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var errHeader = errors.New("header line")
+
+type LineParser func(line string) (measurement.Measurement, error)
+
+// ParseCSVLine acepta "temperatura,humedad,presión" o la misma fila con
+// una marca de tiempo RFC 3339 al inicio.
+func ParseCSVLine(line string) (measurement.Measurement, error) {
+	fields := strings.Split(line, ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	var m measurement.Measurement
+	switch len(fields) {
+	case 3:
+	case 4:
+		if fields[0] == "time" {
+			return m, errHeader
+		}
+		t, err := time.Parse(time.RFC3339Nano, fields[0])
+		if err != nil {
+			return m, fmt.Errorf("invalid time: %w", err)
+		}
+		m.Time = t
+		fields = fields[1:]
+	default:
+		return m, fmt.Errorf("expected 3 or 4 fields, got %d", len(fields))
+	}
+	if fields[0] == "temperature" {
+		return m, errHeader
+	}
+
+	targets := []*float64{&m.Temperature, &m.Humidity, &m.Pressure}
+	names := []string{"temperature", "humidity", "pressure"}
+	for i, target := range targets {
+		value, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return m, fmt.Errorf("invalid %s %q", names[i], fields[i])
+		}
+		*target = value
+	}
+	return m, nil
+}
+
+func ParseJSONLine(line string) (measurement.Measurement, error) {
+	var raw struct {
+		Time        time.Time `json:"time"`
+		Temperature *float64  `json:"temperature"`
+		Humidity    *float64  `json:"humidity"`
+		Pressure    *float64  `json:"pressure"`
+	}
+	if err := json.Unmarshal([]byte(line), &raw); err != nil {
+		return measurement.Measurement{}, err
+	}
+	if raw.Temperature == nil || raw.Humidity == nil || raw.Pressure == nil {
+		return measurement.Measurement{}, errors.New("temperature, humidity and pressure are required")
+	}
+	return measurement.New(raw.Time, *raw.Temperature, *raw.Humidity, *raw.Pressure), nil
+}
+
+// ParseLine detecta el formato de cada línea, útil para sockets donde
+// distintos sensores pueden enviar JSON o CSV.
+func ParseLine(line string) (measurement.Measurement, error) {
+	if strings.HasPrefix(strings.TrimSpace(line), "{") {
+		return ParseJSONLine(line)
+	}
+	return ParseCSVLine(line)
+}
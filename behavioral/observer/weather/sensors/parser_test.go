@@ -0,0 +1,62 @@
+package sensors
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    [3]float64
+		wantErr bool
+		header  bool
+	}{
+		{name: "csv", line: "21.5, 65, 1013.2", want: [3]float64{21.5, 65, 1013.2}},
+		{name: "csv with time", line: "2024-07-01T06:00:00Z,21.5,65,1013.2", want: [3]float64{21.5, 65, 1013.2}},
+		{name: "csv header", line: "temperature,humidity,pressure", header: true},
+		{name: "csv header with time", line: "time,temperature,humidity,pressure", header: true},
+		{name: "csv bad number", line: "21.5,abc,1013", wantErr: true},
+		{name: "csv bad time", line: "yesterday,21.5,65,1013", wantErr: true},
+		{name: "csv wrong field count", line: "21.5,65", wantErr: true},
+		{name: "json", line: `{"temperature":18,"humidity":70,"pressure":1016}`, want: [3]float64{18, 70, 1016}},
+		{name: "json zero values", line: `{"temperature":0,"humidity":0,"pressure":0}`},
+		{name: "json missing field", line: `{"temperature":18,"humidity":70}`, wantErr: true},
+		{name: "json malformed", line: `{"temperature":`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := ParseLine(tt.line)
+			switch {
+			case tt.header:
+				if !errors.Is(err, errHeader) {
+					t.Fatalf("err = %v, want header", err)
+				}
+			case tt.wantErr:
+				if err == nil || errors.Is(err, errHeader) {
+					t.Fatalf("err = %v, want parse error", err)
+				}
+			default:
+				if err != nil {
+					t.Fatalf("ParseLine: %v", err)
+				}
+				got := [3]float64{m.Temperature, m.Humidity, m.Pressure}
+				if got != tt.want {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseCSVLineKeepsTime(t *testing.T) {
+	m, err := ParseCSVLine("2024-07-01T06:30:00Z,21.5,65,1013.2")
+	if err != nil {
+		t.Fatalf("ParseCSVLine: %v", err)
+	}
+	if want := time.Date(2024, 7, 1, 6, 30, 0, 0, time.UTC); !m.Time.Equal(want) {
+		t.Fatalf("Time = %v, want %v", m.Time, want)
+	}
+}
@@ -0,0 +1,87 @@
+package sensors
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// SimulatedSensor genera lecturas alrededor de una base con ruido
+// gaussiano y una deriva constante por lectura. Count 0 genera lecturas
+// hasta que se cancele el contexto.
+type SimulatedSensor struct {
+	Temperature float64
+	Humidity    float64
+	Pressure    float64
+
+	TemperatureNoise float64
+	HumidityNoise    float64
+	PressureNoise    float64
+
+	TemperatureDrift float64
+	HumidityDrift    float64
+	PressureDrift    float64
+
+	Interval time.Duration
+	Count    int
+	Rand     *rand.Rand
+
+	onError ErrorHandler
+}
+
+func NewSimulatedSensor(temperature float64, humidity float64, pressure float64, seed int64) *SimulatedSensor {
+	return &SimulatedSensor{
+		Temperature:      temperature,
+		Humidity:         humidity,
+		Pressure:         pressure,
+		TemperatureNoise: 0.3,
+		HumidityNoise:    1.0,
+		PressureNoise:    0.5,
+		Interval:         time.Second,
+		Rand:             rand.New(rand.NewSource(seed)),
+		onError:          printError,
+	}
+}
+
+// SetErrorHandler recibe las lecturas que el sink rechaza.
+func (ss *SimulatedSensor) SetErrorHandler(handler ErrorHandler) {
+	ss.onError = handler
+}
+
+func (ss *SimulatedSensor) Run(ctx context.Context, sink Sink) error {
+	var ticker *time.Ticker
+	if ss.Interval > 0 {
+		ticker = time.NewTicker(ss.Interval)
+		defer ticker.Stop()
+	}
+
+	for i := 0; ss.Count == 0 || i < ss.Count; i++ {
+		if i > 0 && ticker != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+		} else if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		step := float64(i)
+		temperature := ss.Temperature + ss.TemperatureDrift*step + ss.Rand.NormFloat64()*ss.TemperatureNoise
+		humidity := ss.Humidity + ss.HumidityDrift*step + ss.Rand.NormFloat64()*ss.HumidityNoise
+		pressure := ss.Pressure + ss.PressureDrift*step + ss.Rand.NormFloat64()*ss.PressureNoise
+
+		if err := sink.SetMeasurements(temperature, min(max(humidity, 0), 100), pressure); err != nil {
+			ss.reportError(err)
+		}
+	}
+	return nil
+}
+
+func (ss *SimulatedSensor) reportError(err error) {
+	if ss.onError != nil {
+		ss.onError(err)
+		return
+	}
+	printError(err)
+}
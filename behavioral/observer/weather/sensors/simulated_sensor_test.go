@@ -0,0 +1,61 @@
+package sensors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type failingSink struct{}
+
+func (failingSink) SetMeasurements(float64, float64, float64) error {
+	return errors.New("rejected")
+}
+
+func TestSimulatedSensorDeliversCountReadings(t *testing.T) {
+	sensor := NewSimulatedSensor(18, 99.5, 1016, 7)
+	sensor.Interval = 0
+	sensor.Count = 10
+	sensor.HumidityDrift = 1
+	sink := &recordingSink{}
+
+	if err := sensor.Run(context.Background(), sink); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if sink.len() != 10 {
+		t.Fatalf("got %d readings, want 10", sink.len())
+	}
+	for _, r := range sink.readings {
+		if r[1] < 0 || r[1] > 100 {
+			t.Fatalf("humidity %v outside [0, 100]", r[1])
+		}
+	}
+}
+
+func TestSimulatedSensorReportsSinkErrors(t *testing.T) {
+	sensor := NewSimulatedSensor(18, 70, 1016, 7)
+	sensor.Interval = 0
+	sensor.Count = 3
+	var errs []error
+	sensor.SetErrorHandler(func(err error) { errs = append(errs, err) })
+
+	if err := sensor.Run(context.Background(), failingSink{}); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if len(errs) != 3 {
+		t.Fatalf("got %d errors, want 3", len(errs))
+	}
+}
+
+func TestSimulatedSensorStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	sensor := NewSimulatedSensor(18, 70, 1016, 7)
+	sensor.Interval = time.Millisecond
+
+	err := sensor.Run(ctx, &recordingSink{})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Run = %v, want DeadlineExceeded", err)
+	}
+}
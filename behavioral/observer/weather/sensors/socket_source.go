@@ -0,0 +1,138 @@
+package sensors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+)
+
+// UDPSource trata cada datagrama como una o más líneas de medición.
+type UDPSource struct {
+	address string
+	onError ErrorHandler
+	ready   func(addr net.Addr)
+}
+
+func NewUDPSource(address string) *UDPSource {
+	return &UDPSource{address: address, onError: printError}
+}
+
+func (us *UDPSource) SetErrorHandler(handler ErrorHandler) {
+	us.onError = handler
+}
+
+// OnReady informa la dirección real, útil cuando se escucha en el puerto 0.
+func (us *UDPSource) OnReady(ready func(addr net.Addr)) {
+	us.ready = ready
+}
+
+func (us *UDPSource) Run(ctx context.Context, sink Sink) error {
+	conn, err := net.ListenPacket("udp", us.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if us.ready != nil {
+		us.ready(conn.LocalAddr())
+	}
+
+	buffer := make([]byte, 64*1024)
+	packet := 0
+	for {
+		n, addr, err := conn.ReadFrom(buffer)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		packet++
+		for i, line := range strings.Split(string(buffer[:n]), "\n") {
+			text := strings.TrimSpace(line)
+			if err := deliver(text, ParseLine, sink); err != nil {
+				us.onError(&PacketError{Source: "udp", Packet: packet, Addr: addr.String(), Line: i + 1, Text: text, Err: err})
+			}
+		}
+	}
+}
+
+// TCPSource acepta varias conexiones; cada una es un flujo de líneas.
+type TCPSource struct {
+	address string
+	onError ErrorHandler
+	ready   func(addr net.Addr)
+}
+
+func NewTCPSource(address string) *TCPSource {
+	return &TCPSource{address: address, onError: printError}
+}
+
+func (ts *TCPSource) SetErrorHandler(handler ErrorHandler) {
+	ts.onError = handler
+}
+
+func (ts *TCPSource) OnReady(ready func(addr net.Addr)) {
+	ts.ready = ready
+}
+
+func (ts *TCPSource) Run(ctx context.Context, sink Sink) error {
+	listener, err := net.Listen("tcp", ts.address)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+	stop := context.AfterFunc(ctx, func() { listener.Close() })
+	defer stop()
+
+	if ts.ready != nil {
+		ts.ready(listener.Addr())
+	}
+
+	// Las conexiones comparten el sink, así que se serializan las entregas
+	lockedSink := &lockedSink{sink: sink}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			connStop := context.AfterFunc(ctx, func() { conn.Close() })
+			defer connStop()
+
+			source := NewStreamSource("tcp "+conn.RemoteAddr().String(), conn, ParseLine)
+			source.SetErrorHandler(ts.onError)
+			if err := source.Run(ctx, lockedSink); err != nil && ctx.Err() == nil {
+				ts.onError(err)
+			}
+		}()
+	}
+}
+
+type lockedSink struct {
+	mu   sync.Mutex
+	sink Sink
+}
+
+func (ls *lockedSink) SetMeasurements(tmp float64, hum float64, pre float64) error {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	return ls.sink.SetMeasurements(tmp, hum, pre)
+}
@@ -0,0 +1,117 @@
+package sensors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingSink struct {
+	mu       sync.Mutex
+	readings [][3]float64
+}
+
+func (rs *recordingSink) SetMeasurements(tmp float64, hum float64, pre float64) error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.readings = append(rs.readings, [3]float64{tmp, hum, pre})
+	return nil
+}
+
+func (rs *recordingSink) len() int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return len(rs.readings)
+}
+
+func TestStreamSourceReportsLineNumbers(t *testing.T) {
+	input := "temperature,humidity,pressure\n# comentario\n20,60,1013\n\nbad,60,1013\n21,61,1012\n"
+	source := NewCSVSource(strings.NewReader(input))
+	var errs []error
+	source.SetErrorHandler(func(err error) { errs = append(errs, err) })
+	sink := &recordingSink{}
+
+	if err := source.Run(context.Background(), sink); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if sink.len() != 2 {
+		t.Fatalf("got %d readings, want 2", sink.len())
+	}
+	var lineErr *LineError
+	if len(errs) != 1 || !errors.As(errs[0], &lineErr) || lineErr.Line != 5 {
+		t.Fatalf("errors = %v, want one LineError on line 5", errs)
+	}
+}
+
+func TestStreamSourceSkipsOversizeLines(t *testing.T) {
+	long := strings.Repeat("9", MaxLineLength+10)
+	for name, input := range map[string]string{
+		"middle": "20,60,1013\n" + long + "\n21,61,1012\n",
+		"last":   "20,60,1013\n21,61,1012\n" + long,
+	} {
+		t.Run(name, func(t *testing.T) {
+			source := NewCSVSource(strings.NewReader(input))
+			var errs []error
+			source.SetErrorHandler(func(err error) { errs = append(errs, err) })
+			sink := &recordingSink{}
+
+			if err := source.Run(context.Background(), sink); err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+			if sink.len() != 2 {
+				t.Fatalf("got %d readings, want 2", sink.len())
+			}
+			if len(errs) != 1 || !errors.Is(errs[0], ErrLineTooLong) {
+				t.Fatalf("errors = %v, want one ErrLineTooLong", errs)
+			}
+		})
+	}
+}
+
+func TestUDPSourceReportsPacketErrors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	source := NewUDPSource("127.0.0.1:0")
+	ready := make(chan net.Addr, 1)
+	source.OnReady(func(addr net.Addr) { ready <- addr })
+	errs := make(chan error, 1)
+	source.SetErrorHandler(func(err error) { errs <- err })
+	sink := &recordingSink{}
+
+	done := make(chan error, 1)
+	go func() { done <- source.Run(ctx, sink) }()
+
+	conn, err := net.Dial("udp", (<-ready).String())
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("20,60,1013"))
+	conn.Write([]byte("21,61,1012\nbad,61,1012"))
+
+	select {
+	case err := <-errs:
+		var packetErr *PacketError
+		if !errors.As(err, &packetErr) {
+			t.Fatalf("err = %T %v, want *PacketError", err, err)
+		}
+		if packetErr.Packet != 2 || packetErr.Line != 2 || packetErr.Addr != conn.LocalAddr().String() {
+			t.Fatalf("got packet %d line %d from %s", packetErr.Packet, packetErr.Line, packetErr.Addr)
+		}
+	case <-ctx.Done():
+		t.Fatal("no error reported")
+	}
+	if sink.len() != 2 {
+		t.Fatalf("got %d readings, want 2", sink.len())
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run = %v, want context.Canceled", err)
+	}
+}
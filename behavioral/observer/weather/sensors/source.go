@@ -0,0 +1,55 @@
+package sensors
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+type Sink interface {
+	SetMeasurements(tmp float64, hum float64, pre float64) error
+}
+
+type Source interface {
+	Run(ctx context.Context, sink Sink) error
+}
+
+type ErrorHandler func(err error)
+
+type LineError struct {
+	Source string
+	Line   int
+	Text   string
+	Err    error
+}
+
+func (le *LineError) Error() string {
+	return fmt.Sprintf("%s line %d: %v (%q)", le.Source, le.Line, le.Err, le.Text)
+}
+
+func (le *LineError) Unwrap() error {
+	return le.Err
+}
+
+// PacketError ubica una lectura inválida dentro de un datagrama: el número
+// de paquete recibido, su remitente y la línea dentro del paquete.
+type PacketError struct {
+	Source string
+	Packet int
+	Addr   string
+	Line   int
+	Text   string
+	Err    error
+}
+
+func (pe *PacketError) Error() string {
+	return fmt.Sprintf("%s packet %d from %s, line %d: %v (%q)", pe.Source, pe.Packet, pe.Addr, pe.Line, pe.Err, pe.Text)
+}
+
+func (pe *PacketError) Unwrap() error {
+	return pe.Err
+}
+
+func printError(err error) {
+	fmt.Fprintln(os.Stderr, "sensor:", err)
+}
@@ -0,0 +1,153 @@
+package sensors
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// MaxLineLength limita el largo de cada línea de un flujo. Las líneas más
+// largas se reportan con ErrLineTooLong y se descartan sin cortar el flujo.
+const MaxLineLength = 64 * 1024
+
+var ErrLineTooLong = errors.New("line too long")
+
+// StreamSource lee una medición por línea. Las líneas mal formadas se
+// reportan al ErrorHandler y se descartan sin detener el flujo.
+type StreamSource struct {
+	name    string
+	reader  io.Reader
+	parse   LineParser
+	onError ErrorHandler
+}
+
+func NewStreamSource(name string, reader io.Reader, parse LineParser) *StreamSource {
+	return &StreamSource{
+		name:    name,
+		reader:  reader,
+		parse:   parse,
+		onError: printError,
+	}
+}
+
+func NewCSVSource(reader io.Reader) *StreamSource {
+	return NewStreamSource("csv", reader, ParseCSVLine)
+}
+
+func NewJSONLinesSource(reader io.Reader) *StreamSource {
+	return NewStreamSource("jsonl", reader, ParseJSONLine)
+}
+
+func NewStdinSource() *StreamSource {
+	return NewStreamSource("stdin", os.Stdin, ParseJSONLine)
+}
+
+type FileSource struct {
+	path    string
+	onError ErrorHandler
+}
+
+func NewCSVFileSource(path string) *FileSource {
+	return &FileSource{path: path, onError: printError}
+}
+
+func (fs *FileSource) SetErrorHandler(handler ErrorHandler) {
+	fs.onError = handler
+}
+
+func (fs *FileSource) Run(ctx context.Context, sink Sink) error {
+	file, err := os.Open(fs.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	source := NewStreamSource(fs.path, file, ParseCSVLine)
+	source.SetErrorHandler(fs.onError)
+	return source.Run(ctx, sink)
+}
+
+func (ss *StreamSource) SetErrorHandler(handler ErrorHandler) {
+	ss.onError = handler
+}
+
+func (ss *StreamSource) Run(ctx context.Context, sink Sink) error {
+	splitter := &lineSplitter{limit: MaxLineLength}
+	scanner := bufio.NewScanner(ss.reader)
+	scanner.Buffer(make([]byte, 0, 4096), MaxLineLength)
+	scanner.Split(splitter.split)
+
+	line := 0
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		line++
+		if splitter.oversize {
+			splitter.oversize = false
+			ss.onError(&LineError{Source: ss.name, Line: line, Err: ErrLineTooLong})
+			continue
+		}
+		text := strings.TrimSpace(scanner.Text())
+		if err := deliver(text, ss.parse, sink); err != nil {
+			ss.onError(&LineError{Source: ss.name, Line: line, Text: text, Err: err})
+		}
+	}
+	return scanner.Err()
+}
+
+// deliver entrega una línea al sink; las vacías, los comentarios y los
+// encabezados se ignoran sin error.
+func deliver(text string, parse LineParser, sink Sink) error {
+	if text == "" || strings.HasPrefix(text, "#") {
+		return nil
+	}
+
+	m, err := parse(text)
+	if errors.Is(err, errHeader) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return sink.SetMeasurements(m.Temperature, m.Humidity, m.Pressure)
+}
+
+// lineSplitter corta por líneas como bufio.ScanLines, pero descarta las que
+// no caben en limit en vez de terminar el escaneo con bufio.ErrTooLong. Cada
+// línea descartada produce un token vacío con oversize en true.
+type lineSplitter struct {
+	limit      int
+	discarding bool
+	oversize   bool
+}
+
+func (ls *lineSplitter) split(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if err != nil {
+		return advance, token, err
+	}
+
+	if advance == 0 {
+		switch {
+		case len(data) >= ls.limit:
+			ls.discarding = true
+			return len(data), nil, nil
+		case atEOF && ls.discarding:
+			ls.discarding = false
+			ls.oversize = true
+			return 0, []byte{}, bufio.ErrFinalToken
+		}
+		return 0, nil, nil
+	}
+
+	if ls.discarding {
+		ls.discarding = false
+		ls.oversize = true
+		return advance, []byte{}, nil
+	}
+	return advance, token, nil
+}
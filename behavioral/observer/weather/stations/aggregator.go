@@ -0,0 +1,196 @@
+package stations
+
+import (
+	"designpatterns/behavioral/observer/weather/publisher"
+	"fmt"
+	"sync"
+)
+
+type scope int
+
+const (
+	scopeAll scope = iota
+	scopeStation
+	scopeRegion
+)
+
+// SubscriptionID identifica una suscripción; los listeners pueden ser
+// funciones y no se pueden comparar entre sí.
+type SubscriptionID uint64
+
+type subscription struct {
+	id       SubscriptionID
+	listener StationListener
+	scope    scope
+	key      string
+}
+
+type RegionalAggregate struct {
+	Region      string
+	Stations    int
+	Temperature float64
+	Humidity    float64
+	Pressure    float64
+}
+
+// Aggregator se suscribe a cada WeatherData del registro y reenvía sus
+// mediciones a listeners interesados en una estación, una región o todas.
+// Sigue al registro: las estaciones registradas o dadas de baja después de
+// crearlo también se observan o se dejan de observar.
+type Aggregator struct {
+	mu            sync.RWMutex
+	registry      *Registry
+	subscriptions []subscription
+	nextID        SubscriptionID
+	latest        map[string]Reading
+	observers     map[string]publisher.ObserverID
+}
+
+func NewAggregator(registry *Registry) *Aggregator {
+	a := &Aggregator{
+		registry:  registry,
+		latest:    make(map[string]Reading),
+		observers: make(map[string]publisher.ObserverID),
+	}
+	registry.AddListener(a)
+	return a
+}
+
+// Track registra la estación; el registro avisa al agregador.
+func (a *Aggregator) Track(station *publisher.WeatherData) error {
+	return a.registry.Register(station)
+}
+
+func (a *Aggregator) StationRegistered(station *publisher.WeatherData) {
+	id := station.Subscribe(&stationObserver{station: station, aggregator: a})
+	a.mu.Lock()
+	a.observers[station.GetID()] = id
+	a.mu.Unlock()
+}
+
+func (a *Aggregator) StationUnregistered(station *publisher.WeatherData) {
+	a.mu.Lock()
+	id, ok := a.observers[station.GetID()]
+	delete(a.observers, station.GetID())
+	delete(a.latest, station.GetID())
+	a.mu.Unlock()
+
+	if ok {
+		station.Unsubscribe(id)
+	}
+}
+
+func (a *Aggregator) SubscribeAll(l StationListener) SubscriptionID {
+	return a.subscribe(subscription{listener: l, scope: scopeAll})
+}
+
+func (a *Aggregator) SubscribeStation(id string, l StationListener) (SubscriptionID, error) {
+	if _, err := a.registry.Get(id); err != nil {
+		return 0, err
+	}
+	return a.subscribe(subscription{listener: l, scope: scopeStation, key: id}), nil
+}
+
+func (a *Aggregator) SubscribeRegion(region string, l StationListener) SubscriptionID {
+	return a.subscribe(subscription{listener: l, scope: scopeRegion, key: region})
+}
+
+// Unsubscribe elimina la suscripción e indica si existía.
+func (a *Aggregator) Unsubscribe(id SubscriptionID) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, s := range a.subscriptions {
+		if s.id == id {
+			a.subscriptions = append(a.subscriptions[:i], a.subscriptions[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Aggregator) Latest(id string) (Reading, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	reading, ok := a.latest[id]
+	return reading, ok
+}
+
+func (a *Aggregator) RegionalAverage(region string) (RegionalAggregate, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	aggregate := RegionalAggregate{Region: region}
+	for _, reading := range a.latest {
+		if reading.Location.Region != region {
+			continue
+		}
+		aggregate.Stations++
+		aggregate.Temperature += reading.Temperature
+		aggregate.Humidity += reading.Humidity
+		aggregate.Pressure += reading.Pressure
+	}
+	if aggregate.Stations == 0 {
+		return aggregate, fmt.Errorf("no readings for region %q", region)
+	}
+
+	n := float64(aggregate.Stations)
+	aggregate.Temperature /= n
+	aggregate.Humidity /= n
+	aggregate.Pressure /= n
+	return aggregate, nil
+}
+
+func (a *Aggregator) HottestStation() (Reading, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	var hottest Reading
+	found := false
+	for _, reading := range a.latest {
+		if !found || reading.Temperature > hottest.Temperature ||
+			(reading.Temperature == hottest.Temperature && reading.StationID < hottest.StationID) {
+			hottest = reading
+			found = true
+		}
+	}
+	if !found {
+		return Reading{}, fmt.Errorf("no station readings yet")
+	}
+	return hottest, nil
+}
+
+func (a *Aggregator) subscribe(s subscription) SubscriptionID {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.nextID++
+	s.id = a.nextID
+	a.subscriptions = append(a.subscriptions, s)
+	return s.id
+}
+
+func (a *Aggregator) publish(reading Reading) {
+	a.mu.Lock()
+	a.latest[reading.StationID] = reading
+	var targets []StationListener
+	for _, s := range a.subscriptions {
+		if s.matches(reading) {
+			targets = append(targets, s.listener)
+		}
+	}
+	a.mu.Unlock()
+
+	for _, listener := range targets {
+		listener.UpdateStation(reading)
+	}
+}
+
+func (s subscription) matches(reading Reading) bool {
+	switch s.scope {
+	case scopeStation:
+		return s.key == reading.StationID
+	case scopeRegion:
+		return s.key == reading.Location.Region
+	default:
+		return true
+	}
+}
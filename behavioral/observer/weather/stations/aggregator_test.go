@@ -0,0 +1,117 @@
+package stations
+
+import (
+	"designpatterns/behavioral/observer/weather/publisher"
+	"testing"
+)
+
+var (
+	bogotaLocation    = publisher.Location{Name: "Bogotá", Region: "Andina"}
+	cartagenaLocation = publisher.Location{Name: "Cartagena", Region: "Caribe"}
+)
+
+func TestAggregatorUnsubscribeFuncListener(t *testing.T) {
+	aggregator := NewAggregator(NewRegistry())
+	bogota := publisher.NewWeatherStation("BOG-01", bogotaLocation)
+	if err := aggregator.Track(bogota); err != nil {
+		t.Fatalf("Track: %v", err)
+	}
+
+	calls := 0
+	f := StationListenerFunc(func(Reading) { calls++ })
+	all := aggregator.SubscribeAll(f)
+	region := aggregator.SubscribeRegion("Andina", f)
+
+	bogota.SetMeasurements(14, 80, 1027)
+	if calls != 2 {
+		t.Fatalf("calls = %d, want 2", calls)
+	}
+
+	if !aggregator.Unsubscribe(all) {
+		t.Fatal("Unsubscribe should report an existing subscription")
+	}
+	if aggregator.Unsubscribe(all) {
+		t.Fatal("second Unsubscribe should report a missing subscription")
+	}
+	bogota.SetMeasurements(15, 80, 1027)
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3 (only the region subscription)", calls)
+	}
+
+	aggregator.Unsubscribe(region)
+	bogota.SetMeasurements(16, 80, 1027)
+	if calls != 3 {
+		t.Fatalf("calls = %d, want 3 after unsubscribing everything", calls)
+	}
+}
+
+func TestAggregatorScopes(t *testing.T) {
+	aggregator := NewAggregator(NewRegistry())
+	bogota := publisher.NewWeatherStation("BOG-01", bogotaLocation)
+	cartagena := publisher.NewWeatherStation("CTG-01", cartagenaLocation)
+	for _, station := range []*publisher.WeatherData{bogota, cartagena} {
+		if err := aggregator.Track(station); err != nil {
+			t.Fatalf("Track: %v", err)
+		}
+	}
+
+	var got []string
+	record := StationListenerFunc(func(r Reading) { got = append(got, r.StationID) })
+	if _, err := aggregator.SubscribeStation("CTG-01", record); err != nil {
+		t.Fatalf("SubscribeStation: %v", err)
+	}
+	if _, err := aggregator.SubscribeStation("XXX", record); err == nil {
+		t.Fatal("expected error for unknown station")
+	}
+
+	bogota.SetMeasurements(14, 80, 1027)
+	cartagena.SetMeasurements(31, 85, 1010)
+	if len(got) != 1 || got[0] != "CTG-01" {
+		t.Fatalf("got %v, want [CTG-01]", got)
+	}
+
+	hottest, err := aggregator.HottestStation()
+	if err != nil || hottest.StationID != "CTG-01" {
+		t.Fatalf("HottestStation = %v, %v", hottest.StationID, err)
+	}
+	if _, err := aggregator.RegionalAverage("Pacífico"); err == nil {
+		t.Fatal("expected error for region without readings")
+	}
+}
+
+func TestAggregatorFollowsRegistry(t *testing.T) {
+	registry := NewRegistry()
+	bogota := publisher.NewWeatherStation("BOG-01", bogotaLocation)
+	if err := registry.Register(bogota); err != nil {
+		t.Fatal(err)
+	}
+	aggregator := NewAggregator(registry)
+
+	var got []string
+	aggregator.SubscribeAll(StationListenerFunc(func(r Reading) { got = append(got, r.StationID) }))
+
+	// Registrada después de crear el agregador, directamente en el registro
+	cartagena := publisher.NewWeatherStation("CTG-01", cartagenaLocation)
+	if err := registry.Register(cartagena); err != nil {
+		t.Fatal(err)
+	}
+	bogota.SetMeasurements(14, 80, 1027)
+	cartagena.SetMeasurements(31, 85, 1010)
+	if len(got) != 2 || got[0] != "BOG-01" || got[1] != "CTG-01" {
+		t.Fatalf("got %v, want [BOG-01 CTG-01]", got)
+	}
+
+	if err := registry.Unregister("CTG-01"); err != nil {
+		t.Fatal(err)
+	}
+	cartagena.SetMeasurements(32, 85, 1010)
+	if len(got) != 2 {
+		t.Fatalf("got %v: unregistered station still forwarded", got)
+	}
+	if _, ok := aggregator.Latest("CTG-01"); ok {
+		t.Fatal("Latest should forget unregistered stations")
+	}
+	if err := registry.Unregister("CTG-01"); err == nil {
+		t.Fatal("expected error unregistering an unknown station")
+	}
+}
@@ -0,0 +1,117 @@
+package stations
+
+import (
+	"designpatterns/behavioral/observer/weather/publisher"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	ErrUnknownStation   = errors.New("unknown station")
+	ErrDuplicateStation = errors.New("station already registered")
+	ErrMissingStationID = errors.New("station has no ID")
+)
+
+// RegistryListener recibe las altas y bajas de estaciones, para que quien
+// las observe no dependa de cuándo se registró cada una.
+type RegistryListener interface {
+	StationRegistered(station *publisher.WeatherData)
+	StationUnregistered(station *publisher.WeatherData)
+}
+
+type Registry struct {
+	mu        sync.RWMutex
+	stations  map[string]*publisher.WeatherData
+	listeners []RegistryListener
+}
+
+func NewRegistry() *Registry {
+	return &Registry{stations: make(map[string]*publisher.WeatherData)}
+}
+
+func (r *Registry) Register(station *publisher.WeatherData) error {
+	if station.GetID() == "" {
+		return ErrMissingStationID
+	}
+
+	r.mu.Lock()
+	if _, exists := r.stations[station.GetID()]; exists {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrDuplicateStation, station.GetID())
+	}
+	r.stations[station.GetID()] = station
+	listeners := append([]RegistryListener(nil), r.listeners...)
+	r.mu.Unlock()
+
+	for _, l := range listeners {
+		l.StationRegistered(station)
+	}
+	return nil
+}
+
+func (r *Registry) Unregister(id string) error {
+	r.mu.Lock()
+	station, ok := r.stations[id]
+	if !ok {
+		r.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrUnknownStation, id)
+	}
+	delete(r.stations, id)
+	listeners := append([]RegistryListener(nil), r.listeners...)
+	r.mu.Unlock()
+
+	for _, l := range listeners {
+		l.StationUnregistered(station)
+	}
+	return nil
+}
+
+// AddListener registra l y le notifica las estaciones ya registradas, así
+// cada estación le llega exactamente una vez.
+func (r *Registry) AddListener(l RegistryListener) {
+	r.mu.Lock()
+	r.listeners = append(r.listeners, l)
+	existing := r.sorted()
+	r.mu.Unlock()
+
+	for _, station := range existing {
+		l.StationRegistered(station)
+	}
+}
+
+func (r *Registry) Get(id string) (*publisher.WeatherData, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	station, ok := r.stations[id]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownStation, id)
+	}
+	return station, nil
+}
+
+func (r *Registry) All() []*publisher.WeatherData {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.sorted()
+}
+
+func (r *Registry) sorted() []*publisher.WeatherData {
+	all := make([]*publisher.WeatherData, 0, len(r.stations))
+	for _, station := range r.stations {
+		all = append(all, station)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].GetID() < all[j].GetID() })
+	return all
+}
+
+func (r *Registry) ByRegion(region string) []*publisher.WeatherData {
+	var inRegion []*publisher.WeatherData
+	for _, station := range r.All() {
+		if station.GetLocation().Region == region {
+			inRegion = append(inRegion, station)
+		}
+	}
+	return inRegion
+}
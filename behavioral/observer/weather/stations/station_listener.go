@@ -0,0 +1,21 @@
+package stations
+
+import "designpatterns/behavioral/observer/weather/publisher"
+
+type Reading struct {
+	StationID   string
+	Location    publisher.Location
+	Temperature float64
+	Humidity    float64
+	Pressure    float64
+}
+
+type StationListener interface {
+	UpdateStation(reading Reading)
+}
+
+type StationListenerFunc func(reading Reading)
+
+func (f StationListenerFunc) UpdateStation(reading Reading) {
+	f(reading)
+}
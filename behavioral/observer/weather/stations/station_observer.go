@@ -0,0 +1,20 @@
+package stations
+
+import "designpatterns/behavioral/observer/weather/publisher"
+
+// stationObserver adapta el WeatherListener de una estación para que el
+// agregador sepa de qué estación viene cada medición.
+type stationObserver struct {
+	station    *publisher.WeatherData
+	aggregator *Aggregator
+}
+
+func (so *stationObserver) Update(temperature float64, humidity float64, pressure float64) {
+	so.aggregator.publish(Reading{
+		StationID:   so.station.GetID(),
+		Location:    so.station.GetLocation(),
+		Temperature: temperature,
+		Humidity:    humidity,
+		Pressure:    pressure,
+	})
+}
@@ -0,0 +1,22 @@
+package statistics
+
+// Set agrupa una ventana por cada magnitud medida por la estación.
+type Set struct {
+	Temperature *Window
+	Humidity    *Window
+	Pressure    *Window
+}
+
+func NewSet(newWindow func() *Window) *Set {
+	return &Set{
+		Temperature: newWindow(),
+		Humidity:    newWindow(),
+		Pressure:    newWindow(),
+	}
+}
+
+func (s *Set) Add(temperature float64, humidity float64, pressure float64) {
+	s.Temperature.Add(temperature)
+	s.Humidity.Add(humidity)
+	s.Pressure.Add(pressure)
+}
@@ -0,0 +1,78 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"slices"
+)
+
+var (
+	ErrNoSamples         = errors.New("no samples in window")
+	ErrInvalidPercentile = errors.New("percentile must be between 0 and 100")
+)
+
+type Summary struct {
+	Count  int
+	Min    float64
+	Max    float64
+	Mean   float64
+	Median float64
+	StdDev float64
+}
+
+func Summarize(values []float64) (Summary, error) {
+	if len(values) == 0 {
+		return Summary{}, ErrNoSamples
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	mean := sum / float64(len(sorted))
+
+	variance := 0.0
+	for _, v := range sorted {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(sorted))
+
+	return Summary{
+		Count:  len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   mean,
+		Median: percentileSorted(sorted, 50),
+		StdDev: math.Sqrt(variance),
+	}, nil
+}
+
+func Percentile(values []float64, p float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrNoSamples
+	}
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		return 0, ErrInvalidPercentile
+	}
+
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return percentileSorted(sorted, p), nil
+}
+
+// percentileSorted interpola linealmente entre los dos rangos más cercanos.
+func percentileSorted(sorted []float64, p float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	fraction := rank - float64(lower)
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*fraction
+}
@@ -0,0 +1,54 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+)
+
+var ErrNotEnoughSamples = errors.New("at least two samples at different times are required")
+
+type Trend struct {
+	SlopePerHour float64
+	RSquared     float64
+	Samples      int
+}
+
+// LinearTrend ajusta una recta por mínimos cuadrados sobre las muestras
+// y devuelve la pendiente en unidades por hora.
+func LinearTrend(samples []Sample) (Trend, error) {
+	if len(samples) < 2 {
+		return Trend{}, ErrNotEnoughSamples
+	}
+
+	origin := samples[0].Time
+	n := float64(len(samples))
+	var sumX, sumY float64
+	for _, s := range samples {
+		sumX += s.Time.Sub(origin).Hours()
+		sumY += s.Value
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var sxx, sxy, syy float64
+	for _, s := range samples {
+		dx := s.Time.Sub(origin).Hours() - meanX
+		dy := s.Value - meanY
+		sxx += dx * dx
+		sxy += dx * dy
+		syy += dy * dy
+	}
+	if sxx == 0 {
+		return Trend{}, ErrNotEnoughSamples
+	}
+
+	rSquared := 1.0
+	if syy > 0 {
+		rSquared = math.Min(1, (sxy*sxy)/(sxx*syy))
+	}
+
+	return Trend{
+		SlopePerHour: sxy / sxx,
+		RSquared:     rSquared,
+		Samples:      len(samples),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package statistics
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestLinearTrend(t *testing.T) {
+	start := time.Unix(0, 0)
+	var samples []Sample
+	for i := range 4 {
+		samples = append(samples, Sample{Time: start.Add(time.Duration(i) * 30 * time.Minute), Value: 1013 - float64(i)})
+	}
+	trend, err := LinearTrend(samples)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !near(trend.SlopePerHour, -2) || !near(trend.RSquared, 1) || trend.Samples != 4 {
+		t.Fatalf("trend = %+v", trend)
+	}
+
+	flat, _ := LinearTrend([]Sample{{Time: start, Value: 5}, {Time: start.Add(time.Hour), Value: 5}})
+	if flat.SlopePerHour != 0 || flat.RSquared != 1 {
+		t.Fatalf("flat = %+v", flat)
+	}
+
+	for _, invalid := range [][]Sample{nil, samples[:1], {{Time: start, Value: 1}, {Time: start, Value: 2}}} {
+		if _, err := LinearTrend(invalid); !errors.Is(err, ErrNotEnoughSamples) {
+			t.Errorf("LinearTrend(%v) = %v, want ErrNotEnoughSamples", invalid, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package statistics
+
+import (
+	"designpatterns/internal/clock"
+	"time"
+)
+
+type Sample struct {
+	Time  time.Time
+	Value float64
+}
+
+// Window guarda muestras y descarta las que superan su edad máxima
+// o su cantidad máxima. Con ambos límites en cero guarda todo el histórico.
+type Window struct {
+	maxAge   time.Duration
+	maxCount int
+	clock    clock.Clock
+	samples  []Sample
+}
+
+func NewUnboundedWindow(c clock.Clock) *Window {
+	return newWindow(0, 0, c)
+}
+
+func NewTimeWindow(maxAge time.Duration, c clock.Clock) *Window {
+	return newWindow(maxAge, 0, c)
+}
+
+func NewCountWindow(size int, c clock.Clock) *Window {
+	return newWindow(0, size, c)
+}
+
+func newWindow(maxAge time.Duration, maxCount int, c clock.Clock) *Window {
+	if c == nil {
+		c = clock.NewSystemClock()
+	}
+	return &Window{
+		maxAge:   maxAge,
+		maxCount: maxCount,
+		clock:    c,
+	}
+}
+
+func (w *Window) Add(value float64) {
+	w.samples = append(w.samples, Sample{Time: w.clock.Now(), Value: value})
+	w.evict()
+}
+
+func (w *Window) Len() int {
+	w.evict()
+	return len(w.samples)
+}
+
+func (w *Window) Samples() []Sample {
+	w.evict()
+	samples := make([]Sample, len(w.samples))
+	copy(samples, w.samples)
+	return samples
+}
+
+func (w *Window) Values() []float64 {
+	w.evict()
+	values := make([]float64, len(w.samples))
+	for i, s := range w.samples {
+		values[i] = s.Value
+	}
+	return values
+}
+
+func (w *Window) Latest() (Sample, error) {
+	w.evict()
+	if len(w.samples) == 0 {
+		return Sample{}, ErrNoSamples
+	}
+	return w.samples[len(w.samples)-1], nil
+}
+
+func (w *Window) Summary() (Summary, error) {
+	return Summarize(w.Values())
+}
+
+func (w *Window) Percentile(p float64) (float64, error) {
+	return Percentile(w.Values(), p)
+}
+
+func (w *Window) evict() {
+	drop := 0
+	if w.maxCount > 0 && len(w.samples) > w.maxCount {
+		drop = len(w.samples) - w.maxCount
+	}
+	if w.maxAge > 0 {
+		cutoff := w.clock.Now().Add(-w.maxAge)
+		for drop < len(w.samples) && w.samples[drop].Time.Before(cutoff) {
+			drop++
+		}
+	}
+	if drop > 0 {
+		w.samples = append(w.samples[:0], w.samples[drop:]...)
+	}
+}
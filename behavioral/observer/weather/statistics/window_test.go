@@ -0,0 +1,82 @@
+package statistics
+
+import (
+	"designpatterns/internal/clock"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSummarize(t *testing.T) {
+	got, err := Summarize([]float64{4, 2, 8, 6})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Summary{Count: 4, Min: 2, Max: 8, Mean: 5, Median: 5, StdDev: math.Sqrt(5)}
+	if got.Count != want.Count || got.Min != want.Min || got.Max != want.Max ||
+		!near(got.Mean, want.Mean) || !near(got.Median, want.Median) || !near(got.StdDev, want.StdDev) {
+		t.Fatalf("Summarize = %+v, want %+v", got, want)
+	}
+	if _, err := Summarize(nil); !errors.Is(err, ErrNoSamples) {
+		t.Fatalf("err = %v, want ErrNoSamples", err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{10, 40, 20, 30}
+	tests := []struct {
+		p, want float64
+	}{
+		{0, 10}, {100, 40}, {50, 25}, {25, 17.5}, {90, 37},
+	}
+	for _, tt := range tests {
+		got, err := Percentile(values, tt.p)
+		if err != nil || !near(got, tt.want) {
+			t.Errorf("Percentile(%v) = %v, %v, want %v", tt.p, got, err, tt.want)
+		}
+	}
+	if !slices.Equal(values, []float64{10, 40, 20, 30}) {
+		t.Error("Percentile must not sort the caller's slice")
+	}
+	for _, p := range []float64{-1, 101, math.NaN()} {
+		if _, err := Percentile(values, p); !errors.Is(err, ErrInvalidPercentile) {
+			t.Errorf("Percentile(%v) = %v, want ErrInvalidPercentile", p, err)
+		}
+	}
+}
+
+func TestCountWindow(t *testing.T) {
+	w := NewCountWindow(3, clock.NewFakeClock(time.Unix(0, 0)))
+	for _, v := range []float64{1, 2, 3, 4, 5} {
+		w.Add(v)
+	}
+	if got := w.Values(); !slices.Equal(got, []float64{3, 4, 5}) {
+		t.Fatalf("Values = %v", got)
+	}
+}
+
+func TestTimeWindowEvictsOnRead(t *testing.T) {
+	c := clock.NewFakeClock(time.Unix(0, 0))
+	w := NewTimeWindow(time.Hour, c)
+	w.Add(1)
+	c.Advance(30 * time.Minute)
+	w.Add(2)
+	c.Advance(30 * time.Minute)
+	if w.Len() != 2 {
+		t.Fatalf("a sample exactly maxAge old should be kept, Len = %d", w.Len())
+	}
+	c.Advance(time.Minute)
+	if got := w.Values(); !slices.Equal(got, []float64{2}) {
+		t.Fatalf("Values = %v", got)
+	}
+	c.Advance(time.Hour)
+	if _, err := w.Latest(); !errors.Is(err, ErrNoSamples) {
+		t.Fatalf("err = %v, want ErrNoSamples", err)
+	}
+}
@@ -0,0 +1,28 @@
+package storage
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+type Format string
+
+const (
+	CSV        Format = "csv"
+	JSONLines  Format = "jsonl"
+	timeLayout        = "2006-01-02T15:04:05.999999999Z07:00"
+)
+
+var csvHeader = []string{"time", "temperature", "humidity", "pressure"}
+
+func FormatFromPath(path string) (Format, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return CSV, nil
+	case ".jsonl", ".ndjson":
+		return JSONLines, nil
+	default:
+		return "", fmt.Errorf("unknown measurement file format: %s", path)
+	}
+}
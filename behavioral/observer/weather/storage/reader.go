@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"bufio"
+	"designpatterns/behavioral/observer/weather/measurement"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reader devuelve io.EOF cuando no quedan mediciones.
+type Reader interface {
+	Read() (measurement.Measurement, error)
+}
+
+type CSVReader struct {
+	reader *csv.Reader
+	line   int
+}
+
+func NewCSVReader(r io.Reader) *CSVReader {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	return &CSVReader{reader: reader}
+}
+
+func (cr *CSVReader) Read() (measurement.Measurement, error) {
+	for {
+		record, err := cr.reader.Read()
+		if err != nil {
+			return measurement.Measurement{}, err
+		}
+		cr.line++
+		if cr.line == 1 && record[0] == csvHeader[0] {
+			continue
+		}
+		return parseCSVRecord(record, cr.line)
+	}
+}
+
+func parseCSVRecord(record []string, line int) (measurement.Measurement, error) {
+	t, err := time.Parse(timeLayout, strings.TrimSpace(record[0]))
+	if err != nil {
+		return measurement.Measurement{}, fmt.Errorf("line %d: invalid time: %w", line, err)
+	}
+
+	values := make([]float64, 3)
+	for i := range values {
+		values[i], err = strconv.ParseFloat(strings.TrimSpace(record[i+1]), 64)
+		if err != nil {
+			return measurement.Measurement{}, fmt.Errorf("line %d: invalid %s: %w", line, csvHeader[i+1], err)
+		}
+	}
+
+	return measurement.New(t, values[0], values[1], values[2]), nil
+}
+
+type JSONLinesReader struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func NewJSONLinesReader(r io.Reader) *JSONLinesReader {
+	return &JSONLinesReader{scanner: bufio.NewScanner(r)}
+}
+
+func (jr *JSONLinesReader) Read() (measurement.Measurement, error) {
+	for jr.scanner.Scan() {
+		jr.line++
+		text := strings.TrimSpace(jr.scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var m measurement.Measurement
+		if err := json.Unmarshal([]byte(text), &m); err != nil {
+			return measurement.Measurement{}, fmt.Errorf("line %d: %w", jr.line, err)
+		}
+		return m, nil
+	}
+
+	if err := jr.scanner.Err(); err != nil {
+		return measurement.Measurement{}, err
+	}
+	return measurement.Measurement{}, io.EOF
+}
+
+func NewReader(r io.Reader, format Format) (Reader, error) {
+	switch format {
+	case CSV:
+		return NewCSVReader(r), nil
+	case JSONLines:
+		return NewJSONLinesReader(r), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"designpatterns/internal/clock"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Recorder es un listener que guarda cada llamada a SetMeasurements.
+type Recorder struct {
+	writer  Writer
+	clock   clock.Clock
+	closer  io.Closer
+	onError func(err error)
+}
+
+func NewRecorder(writer Writer, c clock.Clock) *Recorder {
+	if c == nil {
+		c = clock.NewSystemClock()
+	}
+	return &Recorder{
+		writer: writer,
+		clock:  c,
+		onError: func(err error) {
+			fmt.Fprintln(os.Stderr, "recorder:", err)
+		},
+	}
+}
+
+// NewFileRecorder abre el archivo en modo append-only; el formato se
+// deduce de la extensión (.csv o .jsonl).
+func NewFileRecorder(path string, c clock.Clock) (*Recorder, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	writer, err := NewWriter(file, format, info.Size() == 0)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	recorder := NewRecorder(writer, c)
+	recorder.closer = file
+	return recorder, nil
+}
+
+func (r *Recorder) SetErrorHandler(handler func(err error)) {
+	r.onError = handler
+}
+
+func (r *Recorder) Update(temperature float64, humidity float64, pressure float64) {
+	m := measurement.New(r.clock.Now(), temperature, humidity, pressure)
+	if err := r.writer.Write(m); err != nil {
+		r.onError(err)
+	}
+}
+
+func (r *Recorder) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
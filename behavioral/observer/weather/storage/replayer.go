@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"designpatterns/internal/clock"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+type MeasurementSink interface {
+	SetMeasurements(tmp float64, hum float64, pre float64) error
+}
+
+// Replayer reproduce un archivo grabado sobre un WeatherData. Con speed 1
+// respeta los intervalos originales, con 60 va sesenta veces más rápido y
+// con 0 publica todo sin esperas.
+type Replayer struct {
+	reader  Reader
+	sink    MeasurementSink
+	speed   float64
+	clock   *clock.FakeClock
+	sleep   func(ctx context.Context, d time.Duration) error
+	onError func(err error)
+}
+
+func NewReplayer(reader Reader, sink MeasurementSink, speed float64) *Replayer {
+	return &Replayer{
+		reader: reader,
+		sink:   sink,
+		speed:  speed,
+		sleep:  sleepContext,
+		onError: func(err error) {
+			fmt.Fprintln(os.Stderr, "replayer:", err)
+		},
+	}
+}
+
+// SetErrorHandler recibe las mediciones que el sink rechaza; la
+// reproducción continúa con la siguiente.
+func (r *Replayer) SetErrorHandler(handler func(err error)) {
+	r.onError = handler
+}
+
+// SyncClock hace que el reloj indicado marque la hora grabada de cada
+// medición, de modo que las ventanas por tiempo se comporten como el día
+// original.
+func (r *Replayer) SyncClock(c *clock.FakeClock) {
+	r.clock = c
+}
+
+func (r *Replayer) Run(ctx context.Context) (int, error) {
+	var previous time.Time
+	replayed := 0
+
+	for {
+		m, err := r.reader.Read()
+		if errors.Is(err, io.EOF) {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, err
+		}
+
+		if r.speed > 0 && !previous.IsZero() && m.Time.After(previous) {
+			delay := time.Duration(float64(m.Time.Sub(previous)) / r.speed)
+			if err := r.sleep(ctx, delay); err != nil {
+				return replayed, err
+			}
+		}
+		previous = m.Time
+
+		if r.clock != nil {
+			r.clock.Set(m.Time)
+		}
+		if err := r.sink.SetMeasurements(m.Temperature, m.Humidity, m.Pressure); err != nil {
+			r.onError(fmt.Errorf("%s: %w", m.Time.Format(time.RFC3339), err))
+			continue
+		}
+		replayed++
+	}
+}
+
+func ReplayFile(ctx context.Context, path string, sink MeasurementSink, speed float64) (int, error) {
+	format, err := FormatFromPath(path)
+	if err != nil {
+		return 0, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	reader, err := NewReader(file, format)
+	if err != nil {
+		return 0, err
+	}
+	return NewReplayer(reader, sink, speed).Run(ctx)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
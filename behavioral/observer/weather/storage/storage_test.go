@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"designpatterns/behavioral/observer/weather/measurement"
+	"designpatterns/internal/clock"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, time.June, 3, 8, 0, 0, 0, time.UTC)
+
+func sampleMeasurements() []measurement.Measurement {
+	return []measurement.Measurement{
+		measurement.New(start, 21.5, 60, 1013.2),
+		measurement.New(start.Add(10*time.Minute), 22, 58.5, 1012.9),
+		measurement.New(start.Add(25*time.Minute), -3.25, 90, 1001),
+	}
+}
+
+func readAll(t *testing.T, reader Reader) []measurement.Measurement {
+	t.Helper()
+	var read []measurement.Measurement
+	for {
+		m, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return read
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		read = append(read, m)
+	}
+}
+
+func equalMeasurements(a, b []measurement.Measurement) bool {
+	return slices.EqualFunc(a, b, func(x, y measurement.Measurement) bool {
+		return x.Time.Equal(y.Time) && x.Temperature == y.Temperature && x.Humidity == y.Humidity && x.Pressure == y.Pressure
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, format := range []Format{CSV, JSONLines} {
+		var buf bytes.Buffer
+		writer, _ := NewWriter(&buf, format, true)
+		for _, m := range sampleMeasurements() {
+			if err := writer.Write(m); err != nil {
+				t.Fatal(err)
+			}
+		}
+		reader, _ := NewReader(&buf, format)
+		if got := readAll(t, reader); !equalMeasurements(got, sampleMeasurements()) {
+			t.Errorf("%s round trip = %+v", format, got)
+		}
+	}
+}
+
+func TestReadErrorsIncludeLine(t *testing.T) {
+	tests := []struct {
+		format Format
+		input  string
+		want   string
+	}{
+		{CSV, "time,temperature,humidity,pressure\n2024-06-03T08:00:00Z,20,50,1013\nyesterday,20,50,1013\n", "line 3: invalid time"},
+		{CSV, "2024-06-03T08:00:00Z,20,wet,1013\n", "line 1: invalid humidity"},
+		{JSONLines, "{\"temperature\": 20}\n\n{oops\n", "line 3:"},
+	}
+	for _, tt := range tests {
+		reader, _ := NewReader(strings.NewReader(tt.input), tt.format)
+		var err error
+		for err == nil {
+			_, err = reader.Read()
+		}
+		if err == io.EOF || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %q", tt.format, err, tt.want)
+		}
+	}
+}
+
+func TestFileRecorderAppendsWithOneHeader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log.csv")
+	c := clock.NewFakeClock(start)
+	for _, temperature := range []float64{20, 21} {
+		recorder, err := NewFileRecorder(path, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		recorder.Update(temperature, 50, 1013)
+		c.Advance(time.Minute)
+		if err := recorder.Close(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := strings.Count(string(data), "time,temperature"); count != 1 {
+		t.Fatalf("header written %d times:\n%s", count, data)
+	}
+	got := readAll(t, NewCSVReader(bytes.NewReader(data)))
+	if len(got) != 2 || got[1].Temperature != 21 || !got[1].Time.Equal(start.Add(time.Minute)) {
+		t.Fatalf("read back %+v", got)
+	}
+
+	if _, err := NewFileRecorder(filepath.Join(t.TempDir(), "log.txt"), c); err == nil {
+		t.Error("unknown extensions should be rejected")
+	}
+}
+
+// sink anota la hora del reloj en cada medición aceptada y rechaza las
+// que tienen la temperatura rejectAt.
+type sink struct {
+	clock    *clock.FakeClock
+	times    []time.Time
+	rejectAt float64
+}
+
+func (s *sink) SetMeasurements(tmp float64, hum float64, pre float64) error {
+	if tmp == s.rejectAt {
+		return errors.New("rejected")
+	}
+	s.times = append(s.times, s.clock.Now())
+	return nil
+}
+
+func TestReplayer(t *testing.T) {
+	c := clock.NewFakeClock(time.Time{})
+	target := &sink{clock: c, rejectAt: 22}
+	var buf bytes.Buffer
+	writer := NewJSONLinesWriter(&buf)
+	for _, m := range sampleMeasurements() {
+		writer.Write(m)
+	}
+
+	replayer := NewReplayer(NewJSONLinesReader(&buf), target, 60)
+	replayer.SyncClock(c)
+	var delays []time.Duration
+	replayer.sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	var rejected []error
+	replayer.SetErrorHandler(func(err error) { rejected = append(rejected, err) })
+
+	replayed, err := replayer.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed != 2 || len(rejected) != 1 {
+		t.Fatalf("replayed %d, rejected %v", replayed, rejected)
+	}
+	if !slices.Equal(delays, []time.Duration{10 * time.Second, 15 * time.Second}) {
+		t.Fatalf("delays = %v", delays)
+	}
+	if !slices.EqualFunc(target.times, []time.Time{start, start.Add(25 * time.Minute)}, time.Time.Equal) {
+		t.Fatalf("clock was not synced: %v", target.times)
+	}
+}
+
+func TestReplayerStopsWhenCancelled(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewCSVWriter(&buf, true)
+	for _, m := range sampleMeasurements() {
+		writer.Write(m)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	target := &sink{clock: clock.NewFakeClock(start)}
+	replayed, err := NewReplayer(NewCSVReader(&buf), target, 1).Run(ctx)
+	if !errors.Is(err, context.Canceled) || replayed != 1 {
+		t.Fatalf("replayed %d, err = %v", replayed, err)
+	}
+}
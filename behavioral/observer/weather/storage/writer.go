@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type Writer interface {
+	Write(m measurement.Measurement) error
+}
+
+type CSVWriter struct {
+	writer      *csv.Writer
+	writeHeader bool
+}
+
+// NewCSVWriter escribe la cabecera solo si se indica, para poder seguir
+// agregando filas a un archivo existente.
+func NewCSVWriter(w io.Writer, writeHeader bool) *CSVWriter {
+	return &CSVWriter{
+		writer:      csv.NewWriter(w),
+		writeHeader: writeHeader,
+	}
+}
+
+func (cw *CSVWriter) Write(m measurement.Measurement) error {
+	if cw.writeHeader {
+		if err := cw.writer.Write(csvHeader); err != nil {
+			return err
+		}
+		cw.writeHeader = false
+	}
+
+	record := []string{
+		m.Time.Format(timeLayout),
+		strconv.FormatFloat(m.Temperature, 'f', -1, 64),
+		strconv.FormatFloat(m.Humidity, 'f', -1, 64),
+		strconv.FormatFloat(m.Pressure, 'f', -1, 64),
+	}
+	if err := cw.writer.Write(record); err != nil {
+		return err
+	}
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+type JSONLinesWriter struct {
+	encoder *json.Encoder
+}
+
+func NewJSONLinesWriter(w io.Writer) *JSONLinesWriter {
+	return &JSONLinesWriter{encoder: json.NewEncoder(w)}
+}
+
+func (jw *JSONLinesWriter) Write(m measurement.Measurement) error {
+	return jw.encoder.Encode(m)
+}
+
+func NewWriter(w io.Writer, format Format, writeHeader bool) (Writer, error) {
+	switch format {
+	case CSV:
+		return NewCSVWriter(w, writeHeader), nil
+	case JSONLines:
+		return NewJSONLinesWriter(w), nil
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
@@ -0,0 +1,61 @@
+package units
+
+type TemperatureUnit int
+
+const (
+	Celsius TemperatureUnit = iota
+	Fahrenheit
+)
+
+type PressureUnit int
+
+const (
+	HectoPascal PressureUnit = iota
+	InchesOfMercury
+)
+
+const hPaPerInHg = 33.8639
+
+func CelsiusToFahrenheit(c float64) float64 {
+	return c*9/5 + 32
+}
+
+func FahrenheitToCelsius(f float64) float64 {
+	return (f - 32) * 5 / 9
+}
+
+func HectoPascalToInchesOfMercury(hPa float64) float64 {
+	return hPa / hPaPerInHg
+}
+
+func InchesOfMercuryToHectoPascal(inHg float64) float64 {
+	return inHg * hPaPerInHg
+}
+
+func (u TemperatureUnit) FromCelsius(c float64) float64 {
+	if u == Fahrenheit {
+		return CelsiusToFahrenheit(c)
+	}
+	return c
+}
+
+func (u TemperatureUnit) Symbol() string {
+	if u == Fahrenheit {
+		return "°F"
+	}
+	return "°C"
+}
+
+func (u PressureUnit) FromHectoPascal(hPa float64) float64 {
+	if u == InchesOfMercury {
+		return HectoPascalToInchesOfMercury(hPa)
+	}
+	return hPa
+}
+
+func (u PressureUnit) Symbol() string {
+	if u == InchesOfMercury {
+		return "inHg"
+	}
+	return "hPa"
+}
@@ -0,0 +1,47 @@
+package validation
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"fmt"
+	"math"
+)
+
+type Range struct {
+	Min float64
+	Max float64
+}
+
+type RangeValidator struct {
+	Temperature Range
+	Humidity    Range
+	Pressure    Range
+}
+
+// NewPhysicalRangeValidator usa los extremos registrados en la Tierra con
+// algo de margen.
+func NewPhysicalRangeValidator() *RangeValidator {
+	return &RangeValidator{
+		Temperature: Range{Min: -90, Max: 60},
+		Humidity:    Range{Min: 0, Max: 100},
+		Pressure:    Range{Min: 870, Max: 1085},
+	}
+}
+
+func (rv *RangeValidator) Validate(m measurement.Measurement) error {
+	ranges := []Range{rv.Temperature, rv.Humidity, rv.Pressure}
+	for i, field := range fields(m) {
+		if math.IsNaN(field.value) || math.IsInf(field.value, 0) {
+			return &ValidationError{Rule: "range", Field: field.name, Value: field.value, Reason: "is not a number"}
+		}
+		r := ranges[i]
+		if field.value < r.Min || field.value > r.Max {
+			return &ValidationError{
+				Rule:   "range",
+				Field:  field.name,
+				Value:  field.value,
+				Reason: fmt.Sprintf("outside [%g, %g]", r.Min, r.Max),
+			}
+		}
+	}
+	return nil
+}
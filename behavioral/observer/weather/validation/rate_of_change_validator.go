@@ -0,0 +1,55 @@
+package validation
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"fmt"
+	"math"
+	"time"
+)
+
+// RateOfChangeValidator rechaza saltos físicamente imposibles respecto a
+// la última lectura aceptada. Los límites se expresan por hora.
+type RateOfChangeValidator struct {
+	MaxTemperaturePerHour float64
+	MaxHumidityPerHour    float64
+	MaxPressurePerHour    float64
+	MinInterval           time.Duration
+	last                  *measurement.Measurement
+}
+
+func NewRateOfChangeValidator() *RateOfChangeValidator {
+	return &RateOfChangeValidator{
+		MaxTemperaturePerHour: 10,
+		MaxHumidityPerHour:    50,
+		MaxPressurePerHour:    10,
+		MinInterval:           time.Minute,
+	}
+}
+
+func (rv *RateOfChangeValidator) Validate(m measurement.Measurement) error {
+	if rv.last == nil {
+		return nil
+	}
+
+	// Lecturas muy seguidas se comparan como si hubiera pasado MinInterval
+	hours := max(m.Time.Sub(rv.last.Time), rv.MinInterval).Hours()
+	limits := []float64{rv.MaxTemperaturePerHour, rv.MaxHumidityPerHour, rv.MaxPressurePerHour}
+	previous := fields(*rv.last)
+
+	for i, field := range fields(m) {
+		rate := math.Abs(field.value-previous[i].value) / hours
+		if limits[i] > 0 && rate > limits[i] {
+			return &ValidationError{
+				Rule:   "rate-of-change",
+				Field:  field.name,
+				Value:  field.value,
+				Reason: fmt.Sprintf("changed %.1f/h, limit %.1f/h", rate, limits[i]),
+			}
+		}
+	}
+	return nil
+}
+
+func (rv *RateOfChangeValidator) Accepted(m measurement.Measurement) {
+	rv.last = &m
+}
@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"designpatterns/behavioral/observer/weather/statistics"
+	"errors"
+	"fmt"
+	"math"
+)
+
+const spikeRule = "spike"
+
+// SpikeFilter es un filtro de mediana: compara cada valor con la mediana
+// de las últimas lecturas aceptadas y rechaza los que se alejan demasiado.
+// Si se rechazan tantas lecturas seguidas como el tamaño de la ventana se
+// asume un cambio de nivel real y se reinicia el historial.
+//
+// Validate no modifica el estado: el historial y el conteo de rechazos se
+// actualizan en Accepted y Rejected, cuando ya decidió toda la cadena.
+type SpikeFilter struct {
+	MaxDeviation [3]float64
+	size         int
+	history      [3]*statistics.Window
+	rejections   int
+}
+
+// MinSpikeWindow es el tamaño mínimo de la ventana: con una sola lectura
+// cada rechazo ya se tomaría como cambio de nivel y nunca se filtraría nada.
+const MinSpikeWindow = 2
+
+// NewSpikeFilter lleva los tamaños menores a MinSpikeWindow a ese mínimo.
+func NewSpikeFilter(size int, maxTemperature float64, maxHumidity float64, maxPressure float64) *SpikeFilter {
+	size = max(size, MinSpikeWindow)
+	sf := &SpikeFilter{
+		MaxDeviation: [3]float64{maxTemperature, maxHumidity, maxPressure},
+		size:         size,
+	}
+	sf.reset()
+	return sf
+}
+
+func (sf *SpikeFilter) reset() {
+	for i := range sf.history {
+		sf.history[i] = statistics.NewCountWindow(sf.size, nil)
+	}
+	sf.rejections = 0
+}
+
+func (sf *SpikeFilter) Validate(m measurement.Measurement) error {
+	err := sf.spike(m)
+	// Tras size-1 rechazos seguidos, uno más se toma como cambio de nivel
+	if err != nil && sf.rejections+1 >= sf.size {
+		return nil
+	}
+	return err
+}
+
+func (sf *SpikeFilter) Accepted(m measurement.Measurement) {
+	if sf.spike(m) != nil {
+		sf.reset()
+	}
+	sf.rejections = 0
+	for i, field := range fields(m) {
+		sf.history[i].Add(field.value)
+	}
+}
+
+// Rejected solo cuenta los rechazos propios; si otro validador descartó
+// la lectura no es evidencia de un cambio de nivel.
+func (sf *SpikeFilter) Rejected(m measurement.Measurement, err error) {
+	var ve *ValidationError
+	if errors.As(err, &ve) && ve.Rule == spikeRule {
+		sf.rejections++
+	}
+}
+
+func (sf *SpikeFilter) spike(m measurement.Measurement) error {
+	for i, field := range fields(m) {
+		// Con pocas muestras la mediana no es representativa
+		if sf.history[i].Len() < sf.size {
+			continue
+		}
+		median, err := sf.history[i].Percentile(50)
+		if err != nil {
+			continue
+		}
+		deviation := math.Abs(field.value - median)
+		if deviation > sf.MaxDeviation[i] {
+			return &ValidationError{
+				Rule:   spikeRule,
+				Field:  field.name,
+				Value:  field.value,
+				Reason: fmt.Sprintf("deviates %.1f from median %.1f", deviation, median),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"errors"
+	"fmt"
+)
+
+var ErrRejected = errors.New("measurement rejected")
+
+type ValidationError struct {
+	Rule   string
+	Field  string
+	Value  float64
+	Reason string
+}
+
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s %v %s", ve.Rule, ve.Field, ve.Value, ve.Reason)
+}
+
+func (ve *ValidationError) Is(target error) bool {
+	return target == ErrRejected
+}
+
+type Validator interface {
+	Validate(m measurement.Measurement) error
+}
+
+// AcceptObserver lo implementan los validadores con estado, que solo deben
+// aprender de las lecturas que pasaron todas las validaciones.
+type AcceptObserver interface {
+	Accepted(m measurement.Measurement)
+}
+
+// RejectObserver recibe las lecturas que la validación rechazó, con el
+// error que las descartó.
+type RejectObserver interface {
+	Rejected(m measurement.Measurement, err error)
+}
+
+type Chain []Validator
+
+func NewChain(validators ...Validator) Chain {
+	return Chain(validators)
+}
+
+func (c Chain) Validate(m measurement.Measurement) error {
+	for _, v := range c {
+		if err := v.Validate(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c Chain) Accepted(m measurement.Measurement) {
+	for _, v := range c {
+		if observer, ok := v.(AcceptObserver); ok {
+			observer.Accepted(m)
+		}
+	}
+}
+
+func (c Chain) Rejected(m measurement.Measurement, err error) {
+	for _, v := range c {
+		if observer, ok := v.(RejectObserver); ok {
+			observer.Rejected(m, err)
+		}
+	}
+}
+
+type Fault struct {
+	StationID   string
+	Measurement measurement.Measurement
+	Err         error
+}
+
+func fields(m measurement.Measurement) []struct {
+	name  string
+	value float64
+} {
+	return []struct {
+		name  string
+		value float64
+	}{
+		{"temperature", m.Temperature},
+		{"humidity", m.Humidity},
+		{"pressure", m.Pressure},
+	}
+}
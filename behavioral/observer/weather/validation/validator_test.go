@@ -0,0 +1,152 @@
+package validation
+
+import (
+	"designpatterns/behavioral/observer/weather/measurement"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+var start = time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC)
+
+// submit reproduce lo que hace WeatherData: valida y luego confirma o
+// informa el rechazo.
+func submit(c Chain, m measurement.Measurement) error {
+	if err := c.Validate(m); err != nil {
+		c.Rejected(m, err)
+		return err
+	}
+	c.Accepted(m)
+	return nil
+}
+
+func rule(err error) string {
+	var ve *ValidationError
+	if errors.As(err, &ve) {
+		return ve.Rule
+	}
+	return ""
+}
+
+func TestPhysicalRangeValidator(t *testing.T) {
+	tests := []struct {
+		name string
+		m    measurement.Measurement
+		ok   bool
+	}{
+		{"normal", measurement.New(start, 20, 55, 1012), true},
+		{"limits", measurement.New(start, 60, 100, 870), true},
+		{"NaN", measurement.New(start, math.NaN(), 55, 1012), false},
+		{"Inf", measurement.New(start, 20, 55, math.Inf(1)), false},
+		{"humidity below zero", measurement.New(start, 20, -5, 1012), false},
+		{"pressure too high", measurement.New(start, 20, 55, 1100), false},
+	}
+	v := NewPhysicalRangeValidator()
+	for _, tt := range tests {
+		err := v.Validate(tt.m)
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: err = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+		if err != nil && !errors.Is(err, ErrRejected) {
+			t.Errorf("%s: error should match ErrRejected", tt.name)
+		}
+	}
+}
+
+func TestRateOfChangeValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		next    float64
+		ok      bool
+	}{
+		{"slow change", time.Hour, 25, true},
+		{"fast change", time.Hour, 35, false},
+		{"readings closer than MinInterval use MinInterval", time.Millisecond, 20.1, true},
+		{"big jump within MinInterval", time.Millisecond, 21, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChain(NewRateOfChangeValidator())
+			if err := submit(c, measurement.New(start, 20, 55, 1012)); err != nil {
+				t.Fatalf("first reading: %v", err)
+			}
+			err := submit(c, measurement.New(start.Add(tt.elapsed), tt.next, 55, 1012))
+			if (err == nil) != tt.ok {
+				t.Fatalf("err = %v, want ok=%v", err, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSpikeFilterRejectsSpikesAndAcceptsLevelShift(t *testing.T) {
+	c := NewChain(NewSpikeFilter(3, 5, 20, 5))
+	for i, temp := range []float64{20, 20.5, 21} {
+		if err := submit(c, measurement.New(start.Add(time.Duration(i)*time.Minute), temp, 55, 1012)); err != nil {
+			t.Fatalf("warm-up %d: %v", i, err)
+		}
+	}
+
+	// Dos picos seguidos se rechazan; el tercero confirma el cambio de nivel
+	results := []bool{false, false, true}
+	for i, ok := range results {
+		err := submit(c, measurement.New(start, 40, 55, 1012))
+		if (err == nil) != ok {
+			t.Fatalf("spike %d: err = %v, want ok=%v", i, err, ok)
+		}
+		if err != nil && rule(err) != "spike" {
+			t.Fatalf("spike %d: rule = %q", i, rule(err))
+		}
+	}
+}
+
+func TestSpikeFilterIgnoresReadingsRejectedLaterInChain(t *testing.T) {
+	spike := NewSpikeFilter(3, 5, 20, 5)
+	// El rango rechaza temperaturas por encima de 30 después del filtro
+	strict := &RangeValidator{
+		Temperature: Range{Min: -90, Max: 30},
+		Humidity:    Range{Min: 0, Max: 100},
+		Pressure:    Range{Min: 870, Max: 1085},
+	}
+	c := NewChain(spike, strict)
+	for _, temp := range []float64{20, 20, 20} {
+		if err := submit(c, measurement.New(start, temp, 55, 1012)); err != nil {
+			t.Fatalf("warm-up: %v", err)
+		}
+	}
+
+	// Picos que el filtro deja pasar por cambio de nivel pero el rango
+	// rechaza no deben alimentar su historial ni su conteo.
+	for range 5 {
+		if err := submit(c, measurement.New(start, 50, 55, 1012)); err == nil {
+			t.Fatal("expected the chain to reject 50°C")
+		}
+	}
+	if spike.rejections != 2 {
+		t.Fatalf("rejections = %d, want 2", spike.rejections)
+	}
+	if median, _ := spike.history[0].Percentile(50); median != 20 {
+		t.Fatalf("median = %v, want 20: rejected readings leaked into the history", median)
+	}
+	if err := submit(c, measurement.New(start, 20.5, 55, 1012)); err != nil {
+		t.Fatalf("normal reading after rejections: %v", err)
+	}
+	if spike.rejections != 0 {
+		t.Fatalf("rejections = %d after an accepted reading, want 0", spike.rejections)
+	}
+}
+
+func TestSpikeFilterClampsSmallWindows(t *testing.T) {
+	for _, size := range []int{-1, 0, 1} {
+		c := NewChain(NewSpikeFilter(size, 5, 20, 5))
+		for range MinSpikeWindow {
+			if err := submit(c, measurement.New(start, 20, 55, 1012)); err != nil {
+				t.Fatalf("size %d warm-up: %v", size, err)
+			}
+		}
+		if err := submit(c, measurement.New(start, 40, 55, 1012)); rule(err) != "spike" {
+			t.Fatalf("size %d: err = %v, want a spike rejection", size, err)
+		}
+	}
+}
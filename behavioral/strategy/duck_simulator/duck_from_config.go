@@ -0,0 +1,21 @@
+package main
+
+import "designpatterns/behavioral/strategy/duck_simulator/registry"
+
+// NewDuckFromConfig arma un pato a partir de una configuración como
+// "fly=rocket,quack=squeak" y los comportamientos del registro.
+func NewDuckFromConfig(config string, behaviors *registry.Registry) (*Duck, error) {
+	parsed, err := registry.ParseConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	fly, quack, err := behaviors.Build(parsed)
+	if err != nil {
+		return nil, err
+	}
+
+	duck := &Duck{}
+	duck.SetFlyBehavior(fly)
+	duck.SetQuackBehavior(quack)
+	return duck, nil
+}
@@ -0,0 +1,8 @@
+package fly_behavior
+
+// FlyFunc permite usar una función como comportamiento de vuelo.
+type FlyFunc func()
+
+func (f FlyFunc) Fly() {
+	f()
+}
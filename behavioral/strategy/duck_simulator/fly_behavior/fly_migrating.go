@@ -0,0 +1,15 @@
+package fly_behavior
+
+import "fmt"
+
+type FlyMigrating struct {
+	Destination string
+}
+
+func (f *FlyMigrating) Fly() {
+	destination := f.Destination
+	if destination == "" {
+		destination = "south"
+	}
+	fmt.Printf("I'm migrating %s with the flock!\n", destination)
+}
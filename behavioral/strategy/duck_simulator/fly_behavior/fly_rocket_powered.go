@@ -0,0 +1,9 @@
+package fly_behavior
+
+import "fmt"
+
+type FlyRocketPowered struct{}
+
+func (f *FlyRocketPowered) Fly() {
+	fmt.Println("I'm flying with a rocket!")
+}
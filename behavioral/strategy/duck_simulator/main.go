@@ -3,6 +3,7 @@ package main
 import (
 	"designpatterns/behavioral/strategy/duck_simulator/fly_behavior"
 	"designpatterns/behavioral/strategy/duck_simulator/quack_behavior"
+	"designpatterns/behavioral/strategy/duck_simulator/registry"
 	"fmt"
 	"strings"
 )
@@ -54,5 +55,27 @@ func main() {
 	genericDuck.PerformFly()
 	genericDuck.PerformQuack()
 
+	fmt.Println("\n" + strings.Repeat("-", 50) + "\n")
+
+	fmt.Println("5. Patos armados desde configuración con el registro de comportamientos:")
+	behaviors := registry.NewDefault()
+	// Un comportamiento nuevo se registra sin tocar duck.go
+	behaviors.RegisterFly("glide", func() fly_behavior.FlyBehavior {
+		return fly_behavior.FlyFunc(func() { fmt.Println("I'm gliding on the wind") })
+	})
+	fmt.Println("Fly behaviors:", strings.Join(behaviors.FlyNames(), ", "))
+	fmt.Println("Quack behaviors:", strings.Join(behaviors.QuackNames(), ", "))
+
+	for _, config := range []string{"fly=rocket,quack=squeak", "fly=migrating,quack=duck-call", "fly=glide", "fly=jet,quack=quack"} {
+		fmt.Printf("\nConfig %q:\n", config)
+		duck, err := NewDuckFromConfig(config, behaviors)
+		if err != nil {
+			fmt.Println("Error:", err)
+			continue
+		}
+		duck.PerformFly()
+		duck.PerformQuack()
+	}
+
 	fmt.Println("\n=== Demo completado ===")
 }
@@ -0,0 +1,10 @@
+package quack_behavior
+
+import "fmt"
+
+// DuckCall es el reclamo de los cazadores: imita el graznido sin ser un pato.
+type DuckCall struct{}
+
+func (q *DuckCall) Quack() {
+	fmt.Println("Kwak! (duck call)")
+}
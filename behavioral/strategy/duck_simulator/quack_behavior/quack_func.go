@@ -0,0 +1,8 @@
+package quack_behavior
+
+// QuackFunc permite usar una función como comportamiento de graznido.
+type QuackFunc func()
+
+func (f QuackFunc) Quack() {
+	f()
+}
@@ -0,0 +1,76 @@
+package registry
+
+import (
+	"designpatterns/behavioral/strategy/duck_simulator/fly_behavior"
+	"designpatterns/behavioral/strategy/duck_simulator/quack_behavior"
+	"fmt"
+	"strings"
+)
+
+// Config indica qué comportamiento usar para volar y para graznar. Un
+// campo vacío deja ese comportamiento sin asignar.
+type Config struct {
+	Fly   string
+	Quack string
+}
+
+// ParseConfig lee configuraciones como "fly=rocket,quack=squeak".
+func ParseConfig(text string) (Config, error) {
+	var config Config
+	seen := make(map[string]bool)
+	for _, pair := range strings.Split(text, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+		if !ok || value == "" {
+			return Config{}, fmt.Errorf("invalid config entry %q: expected key=value", strings.TrimSpace(pair))
+		}
+		if seen[key] {
+			return Config{}, fmt.Errorf("duplicate config key %q", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "fly":
+			config.Fly = value
+		case "quack":
+			config.Quack = value
+		default:
+			return Config{}, fmt.Errorf("unknown config key %q: expected fly or quack", key)
+		}
+	}
+	return config, nil
+}
+
+func (c Config) String() string {
+	var parts []string
+	if c.Fly != "" {
+		parts = append(parts, "fly="+c.Fly)
+	}
+	if c.Quack != "" {
+		parts = append(parts, "quack="+c.Quack)
+	}
+	return strings.Join(parts, ",")
+}
+
+// Build crea las estrategias de la configuración; las que no se indican
+// vuelven como nil.
+func (r *Registry) Build(config Config) (fly_behavior.FlyBehavior, quack_behavior.QuackBehavior, error) {
+	var fly fly_behavior.FlyBehavior
+	var quack quack_behavior.QuackBehavior
+	var err error
+	if config.Fly != "" {
+		if fly, err = r.Fly(config.Fly); err != nil {
+			return nil, nil, err
+		}
+	}
+	if config.Quack != "" {
+		if quack, err = r.Quack(config.Quack); err != nil {
+			return nil, nil, err
+		}
+	}
+	return fly, quack, nil
+}
@@ -0,0 +1,118 @@
+package registry
+
+import (
+	"designpatterns/behavioral/strategy/duck_simulator/fly_behavior"
+	"designpatterns/behavioral/strategy/duck_simulator/quack_behavior"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrUnknownBehavior   = errors.New("unknown behavior")
+	ErrDuplicateBehavior = errors.New("behavior already registered")
+	ErrEmptyName         = errors.New("behavior name cannot be empty")
+)
+
+// Las fábricas crean una estrategia nueva para cada pato, así dos patos
+// nunca comparten estado.
+type FlyFactory func() fly_behavior.FlyBehavior
+type QuackFactory func() quack_behavior.QuackBehavior
+
+type Registry struct {
+	mu    sync.RWMutex
+	fly   map[string]FlyFactory
+	quack map[string]QuackFactory
+}
+
+func New() *Registry {
+	return &Registry{
+		fly:   make(map[string]FlyFactory),
+		quack: make(map[string]QuackFactory),
+	}
+}
+
+// NewDefault trae registrados todos los comportamientos del simulador.
+func NewDefault() *Registry {
+	r := New()
+	r.RegisterFly("wings", func() fly_behavior.FlyBehavior { return &fly_behavior.FlyWithWings{} })
+	r.RegisterFly("noway", func() fly_behavior.FlyBehavior { return &fly_behavior.FlyNoWay{} })
+	r.RegisterFly("rocket", func() fly_behavior.FlyBehavior { return &fly_behavior.FlyRocketPowered{} })
+	r.RegisterFly("migrating", func() fly_behavior.FlyBehavior { return &fly_behavior.FlyMigrating{} })
+	r.RegisterQuack("quack", func() quack_behavior.QuackBehavior { return &quack_behavior.Quack{} })
+	r.RegisterQuack("squeak", func() quack_behavior.QuackBehavior { return &quack_behavior.Squeak{} })
+	r.RegisterQuack("mute", func() quack_behavior.QuackBehavior { return &quack_behavior.MuteQuack{} })
+	r.RegisterQuack("duckcall", func() quack_behavior.QuackBehavior { return &quack_behavior.DuckCall{} })
+	return r
+}
+
+func (r *Registry) RegisterFly(name string, factory FlyFactory) error {
+	return register(&r.mu, r.fly, name, factory)
+}
+
+func (r *Registry) RegisterQuack(name string, factory QuackFactory) error {
+	return register(&r.mu, r.quack, name, factory)
+}
+
+func (r *Registry) Fly(name string) (fly_behavior.FlyBehavior, error) {
+	factory, err := lookup(&r.mu, r.fly, "fly", name)
+	if err != nil {
+		return nil, err
+	}
+	return factory(), nil
+}
+
+func (r *Registry) Quack(name string) (quack_behavior.QuackBehavior, error) {
+	factory, err := lookup(&r.mu, r.quack, "quack", name)
+	if err != nil {
+		return nil, err
+	}
+	return factory(), nil
+}
+
+func (r *Registry) FlyNames() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.fly))
+}
+
+func (r *Registry) QuackNames() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return slices.Sorted(maps.Keys(r.quack))
+}
+
+func register[F any](mu *sync.RWMutex, factories map[string]F, name string, factory F) error {
+	name = normalize(name)
+	if name == "" {
+		return ErrEmptyName
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := factories[name]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateBehavior, name)
+	}
+	factories[name] = factory
+	return nil
+}
+
+func lookup[F any](mu *sync.RWMutex, factories map[string]F, kind string, name string) (F, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	factory, ok := factories[normalize(name)]
+	if !ok {
+		var zero F
+		return zero, fmt.Errorf("%w: %s=%s", ErrUnknownBehavior, kind, name)
+	}
+	return factory, nil
+}
+
+// normalize hace que "Duck-Call", "duck_call" y "duckcall" sean el mismo nombre.
+func normalize(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer("-", "", "_", "", " ", "").Replace(name)
+}
@@ -0,0 +1,96 @@
+package registry
+
+import (
+	"designpatterns/behavioral/strategy/duck_simulator/fly_behavior"
+	"designpatterns/behavioral/strategy/duck_simulator/quack_behavior"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestLookupNormalizesNames(t *testing.T) {
+	r := NewDefault()
+	for _, name := range []string{"duckcall", "Duck-Call", " duck_call ", "DUCK CALL"} {
+		quack, err := r.Quack(name)
+		if err != nil {
+			t.Errorf("Quack(%q): %v", name, err)
+			continue
+		}
+		if _, ok := quack.(*quack_behavior.DuckCall); !ok {
+			t.Errorf("Quack(%q) = %T", name, quack)
+		}
+	}
+	if _, err := r.Fly("teleport"); !errors.Is(err, ErrUnknownBehavior) {
+		t.Errorf("err = %v, want ErrUnknownBehavior", err)
+	}
+}
+
+func TestFactoriesCreateFreshBehaviors(t *testing.T) {
+	r := NewDefault()
+	first, _ := r.Fly("migrating")
+	second, _ := r.Fly("migrating")
+	first.(*fly_behavior.FlyMigrating).Destination = "north"
+	if second.(*fly_behavior.FlyMigrating).Destination != "" {
+		t.Fatal("two ducks share the same behavior")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	r := New()
+	noop := func() fly_behavior.FlyBehavior { return fly_behavior.FlyFunc(func() {}) }
+	if err := r.RegisterFly("Glide", noop); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.RegisterFly("glide", noop); !errors.Is(err, ErrDuplicateBehavior) {
+		t.Errorf("err = %v, want ErrDuplicateBehavior", err)
+	}
+	if err := r.RegisterFly(" - ", noop); !errors.Is(err, ErrEmptyName) {
+		t.Errorf("err = %v, want ErrEmptyName", err)
+	}
+	if names := r.FlyNames(); !slices.Equal(names, []string{"glide"}) {
+		t.Errorf("FlyNames = %v", names)
+	}
+	if names := NewDefault().QuackNames(); !slices.Equal(names, []string{"duckcall", "mute", "quack", "squeak"}) {
+		t.Errorf("QuackNames = %v", names)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	tests := []struct {
+		text string
+		want Config
+	}{
+		{"fly=rocket,quack=squeak", Config{Fly: "rocket", Quack: "squeak"}},
+		{" Quack = mute , ", Config{Quack: "mute"}},
+		{"", Config{}},
+	}
+	for _, tt := range tests {
+		got, err := ParseConfig(tt.text)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseConfig(%q) = %+v, %v, want %+v", tt.text, got, err, tt.want)
+		}
+		if again, _ := ParseConfig(got.String()); again != got {
+			t.Errorf("String() of %+v does not round trip", got)
+		}
+	}
+
+	for _, invalid := range []string{"fly", "fly=", "fly=wings,fly=rocket", "swim=fast"} {
+		if _, err := ParseConfig(invalid); err == nil {
+			t.Errorf("ParseConfig(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestBuild(t *testing.T) {
+	r := NewDefault()
+	fly, quack, err := r.Build(Config{Fly: "rocket"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fly.(*fly_behavior.FlyRocketPowered); !ok || quack != nil {
+		t.Fatalf("Build = %T, %T", fly, quack)
+	}
+	if _, _, err := r.Build(Config{Fly: "wings", Quack: "honk"}); !errors.Is(err, ErrUnknownBehavior) {
+		t.Fatalf("err = %v, want ErrUnknownBehavior", err)
+	}
+}
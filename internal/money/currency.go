@@ -0,0 +1,47 @@
+package money
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Currency struct {
+	Code   string
+	Symbol string
+	Digits int
+}
+
+var (
+	CurrencyUSD = Currency{Code: "USD", Symbol: "$", Digits: 2}
+	CurrencyEUR = Currency{Code: "EUR", Symbol: "€", Digits: 2}
+	CurrencyGBP = Currency{Code: "GBP", Symbol: "£", Digits: 2}
+	CurrencyJPY = Currency{Code: "JPY", Symbol: "¥", Digits: 0}
+)
+
+var currencies = map[string]Currency{
+	CurrencyUSD.Code: CurrencyUSD,
+	CurrencyEUR.Code: CurrencyEUR,
+	CurrencyGBP.Code: CurrencyGBP,
+	CurrencyJPY.Code: CurrencyJPY,
+}
+
+func CurrencyByCode(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, fmt.Errorf("unknown currency: %q", code)
+	}
+	return currency, nil
+}
+
+// scale es la cantidad de unidades menores por unidad (100 centavos por dólar).
+func (c Currency) scale() int64 {
+	scale := int64(1)
+	for range c.Digits {
+		scale *= 10
+	}
+	return scale
+}
+
+func (c Currency) String() string {
+	return c.Code
+}
@@ -0,0 +1,91 @@
+package money
+
+import (
+	"strconv"
+	"strings"
+)
+
+type Locale struct {
+	Name             string
+	DecimalSeparator string
+	GroupSeparator   string
+	SymbolAfter      bool
+	SymbolSpace      bool
+}
+
+var (
+	EnUS = Locale{Name: "en-US", DecimalSeparator: ".", GroupSeparator: ","}
+	EsES = Locale{Name: "es-ES", DecimalSeparator: ",", GroupSeparator: ".", SymbolAfter: true, SymbolSpace: true}
+	EsAR = Locale{Name: "es-AR", DecimalSeparator: ",", GroupSeparator: ".", SymbolSpace: true}
+	DeDE = Locale{Name: "de-DE", DecimalSeparator: ",", GroupSeparator: ".", SymbolAfter: true, SymbolSpace: true}
+	FrFR = Locale{Name: "fr-FR", DecimalSeparator: ",", GroupSeparator: " ", SymbolAfter: true, SymbolSpace: true}
+)
+
+// Format escribe el importe con los separadores y la posición del símbolo
+// del locale: $1,234.50 en en-US, 1.234,50 € en es-ES.
+func (m Money) Format(locale Locale) string {
+	return m.format(locale, m.currency.Symbol)
+}
+
+// FormatCode usa el código ISO en lugar del símbolo: USD 1,234.50.
+func (m Money) FormatCode(locale Locale) string {
+	return m.format(Locale{
+		DecimalSeparator: locale.DecimalSeparator,
+		GroupSeparator:   locale.GroupSeparator,
+		SymbolAfter:      locale.SymbolAfter,
+		SymbolSpace:      true,
+	}, m.currency.Code)
+}
+
+func (m Money) format(locale Locale, symbol string) string {
+	// En uint64 el valor absoluto del mínimo int64 sí entra
+	amount := uint64(m.amount)
+	if m.amount < 0 {
+		amount = -amount
+	}
+	scale := uint64(m.currency.scale())
+	number := group(strconv.FormatUint(amount/scale, 10), locale.GroupSeparator)
+	if m.currency.Digits > 0 {
+		fraction := strconv.FormatUint(amount%scale, 10)
+		number += locale.DecimalSeparator + strings.Repeat("0", m.currency.Digits-len(fraction)) + fraction
+	}
+
+	space := ""
+	if locale.SymbolSpace {
+		space = " "
+	}
+	var formatted string
+	if locale.SymbolAfter {
+		formatted = number + space + symbol
+	} else {
+		formatted = symbol + space + number
+	}
+	if m.amount < 0 {
+		return "-" + formatted
+	}
+	return formatted
+}
+
+func group(digits string, separator string) string {
+	if separator == "" || len(digits) <= 3 {
+		return digits
+	}
+	var b strings.Builder
+	first := len(digits) % 3
+	if first > 0 {
+		b.WriteString(digits[:first])
+	}
+	for i := first; i < len(digits); i += 3 {
+		if b.Len() > 0 {
+			b.WriteString(separator)
+		}
+		b.WriteString(digits[i : i+3])
+	}
+	return b.String()
+}
+
+// Decimal devuelve el importe sin símbolo ni separadores de miles, con
+// punto decimal: "-1234.50". Es el formato que usan JSON y YAML.
+func (m Money) Decimal() string {
+	return m.format(Locale{DecimalSeparator: "."}, "")
+}
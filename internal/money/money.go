@@ -0,0 +1,302 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidRatios = errors.New("ratios must be non-negative and add up to more than zero")
+	ErrNotFinite     = errors.New("amount is not a finite number")
+	ErrOverflow      = errors.New("amount does not fit in minor units")
+)
+
+type CurrencyMismatchError struct {
+	Expected Currency
+	Actual   Currency
+}
+
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("currency mismatch: expected %s, got %s", e.Expected, e.Actual)
+}
+
+// Money guarda el importe en unidades menores (centavos) para que las
+// sumas y los redondeos sean exactos.
+type Money struct {
+	amount   int64
+	currency Currency
+}
+
+func New(minorUnits int64, currency Currency) Money {
+	return Money{amount: minorUnits, currency: currency}
+}
+
+// FromFloat redondea al centavo más cercano usando la representación
+// decimal más corta del float, así 2.675 queda en 2.68 y no en 2.67.
+func FromFloat(value float64, currency Currency) (Money, error) {
+	rat, err := ratFromFloat(value)
+	if err != nil {
+		return Money{}, err
+	}
+	rat.Mul(rat, new(big.Rat).SetInt64(currency.scale()))
+	amount, err := round(rat, HalfUp)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %v %s", err, value, currency)
+	}
+	return Money{amount: amount, currency: currency}, nil
+}
+
+// MustFromFloat es FromFloat para importes fijos en el código; entra en
+// pánico con NaN, infinitos o importes fuera de rango.
+func MustFromFloat(value float64, currency Currency) Money {
+	m, err := FromFloat(value, currency)
+	if err != nil {
+		panic(fmt.Sprintf("money: %v", err))
+	}
+	return m
+}
+
+// Parse lee un importe decimal como "3.49" o "-0.5" sin pasar por float64.
+func Parse(value string, currency Currency) (Money, error) {
+	value = strings.TrimSpace(value)
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok || strings.ContainsAny(value, "/eE") {
+		return Money{}, fmt.Errorf("invalid amount: %q", value)
+	}
+	rat.Mul(rat, new(big.Rat).SetInt64(currency.scale()))
+	if !rat.IsInt() {
+		return Money{}, fmt.Errorf("amount %q has more than %d decimals for %s", value, currency.Digits, currency)
+	}
+	if !rat.Num().IsInt64() {
+		return Money{}, fmt.Errorf("%w: %q %s", ErrOverflow, value, currency)
+	}
+	return Money{amount: rat.Num().Int64(), currency: currency}, nil
+}
+
+// USD y EUR usan MustFromFloat: son para precios fijos en el código.
+func USD(amount float64) Money {
+	return MustFromFloat(amount, CurrencyUSD)
+}
+
+func EUR(amount float64) Money {
+	return MustFromFloat(amount, CurrencyEUR)
+}
+
+func Zero(currency Currency) Money {
+	return Money{currency: currency}
+}
+
+func (m Money) MinorUnits() int64 {
+	return m.amount
+}
+
+func (m Money) Currency() Currency {
+	return m.currency
+}
+
+func (m Money) Float64() float64 {
+	return float64(m.amount) / float64(m.currency.scale())
+}
+
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+func (m Money) SameCurrency(other Money) bool {
+	return m.currency == other.currency
+}
+
+// Add y Sub entran en pánico si las monedas difieren: mezclar monedas es
+// un error de programación, igual que decorar una bebida nil. También
+// entran en pánico, con un error que envuelve ErrOverflow, si el
+// resultado no entra en un int64.
+func (m Money) Add(other Money) Money {
+	m.mustMatch(other)
+	amount, ok := add64(m.amount, other.amount)
+	mustFit(ok, "%s + %s", m, other)
+	return Money{amount: amount, currency: m.currency}
+}
+
+func (m Money) Sub(other Money) Money {
+	m.mustMatch(other)
+	amount, ok := sub64(m.amount, other.amount)
+	mustFit(ok, "%s - %s", m, other)
+	return Money{amount: amount, currency: m.currency}
+}
+
+func (m Money) Mul(quantity int64) Money {
+	amount, ok := mul64(m.amount, quantity)
+	mustFit(ok, "%s * %d", m, quantity)
+	return Money{amount: amount, currency: m.currency}
+}
+
+// MulRate aplica un porcentaje o tasa (p. ej. 0.0825 de impuesto) y
+// redondea el resultado a la unidad menor.
+func (m Money) MulRate(rate float64, mode RoundingMode) (Money, error) {
+	rat, err := ratFromFloat(rate)
+	if err != nil {
+		return Money{}, err
+	}
+	rat.Mul(rat, new(big.Rat).SetInt64(m.amount))
+	amount, err := round(rat, mode)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %s * %v", err, m, rate)
+	}
+	return Money{amount: amount, currency: m.currency}, nil
+}
+
+// Negate entra en pánico con el mínimo int64, que no tiene opuesto.
+func (m Money) Negate() Money {
+	mustFit(m.amount != math.MinInt64, "-(%s)", m)
+	return Money{amount: -m.amount, currency: m.currency}
+}
+
+// Cmp compara importes de la misma moneda; entre monedas distintas
+// ordena por código para que el orden sea estable.
+func (m Money) Cmp(other Money) int {
+	if !m.SameCurrency(other) {
+		return strings.Compare(m.currency.Code, other.currency.Code)
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1
+	case m.amount > other.amount:
+		return 1
+	}
+	return 0
+}
+
+func (m Money) Equal(other Money) bool {
+	return m.SameCurrency(other) && m.amount == other.amount
+}
+
+func (m Money) LessThan(other Money) bool {
+	m.mustMatch(other)
+	return m.amount < other.amount
+}
+
+func (m Money) GreaterThan(other Money) bool {
+	m.mustMatch(other)
+	return m.amount > other.amount
+}
+
+// Allocate reparte el importe según los ratios sin perder centavos: el
+// resto se entrega de a uno a las primeras partes.
+func (m Money) Allocate(ratios ...int) ([]Money, error) {
+	total := 0
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, ErrInvalidRatios
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, ErrInvalidRatios
+	}
+
+	parts := make([]Money, len(ratios))
+	remainder := m.amount
+	for i, ratio := range ratios {
+		// amount*ratio puede no entrar en un int64, pero la parte sí
+		share := new(big.Int).Mul(big.NewInt(m.amount), big.NewInt(int64(ratio)))
+		share.Quo(share, big.NewInt(int64(total)))
+		parts[i] = Money{amount: share.Int64(), currency: m.currency}
+		remainder -= parts[i].amount
+	}
+
+	step := int64(1)
+	if remainder < 0 {
+		step = -1
+	}
+	for i := 0; remainder != 0; i = (i + 1) % len(parts) {
+		if ratios[i] == 0 {
+			continue
+		}
+		parts[i].amount += step
+		remainder -= step
+	}
+	return parts, nil
+}
+
+// Split divide el importe en n partes iguales.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("cannot split into %d parts", n)
+	}
+	ratios := make([]int, n)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Sum suma importes de la misma moneda; sin valores devuelve cero.
+func Sum(currency Currency, values ...Money) (Money, error) {
+	total := Zero(currency)
+	for _, value := range values {
+		if value.currency != currency {
+			return Money{}, &CurrencyMismatchError{Expected: currency, Actual: value.currency}
+		}
+		amount, ok := add64(total.amount, value.amount)
+		if !ok {
+			return Money{}, fmt.Errorf("%w: sum of %d amounts in %s", ErrOverflow, len(values), currency)
+		}
+		total.amount = amount
+	}
+	return total, nil
+}
+
+func ratFromFloat(value float64) (*big.Rat, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil, fmt.Errorf("%w: %v", ErrNotFinite, value)
+	}
+	rat, _ := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	return rat, nil
+}
+
+func add64(a, b int64) (int64, bool) {
+	sum := a + b
+	return sum, (b >= 0) == (sum >= a)
+}
+
+func sub64(a, b int64) (int64, bool) {
+	diff := a - b
+	return diff, (b >= 0) == (diff <= a)
+}
+
+func mul64(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	product := a * b
+	if product/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64) {
+		return 0, false
+	}
+	return product, true
+}
+
+// mustFit entra en pánico como mustMatch: desbordar un int64 de centavos
+// es un error de programación, no un importe que se pueda redondear.
+func mustFit(ok bool, format string, args ...any) {
+	if !ok {
+		panic(fmt.Errorf("%w: "+format, append([]any{ErrOverflow}, args...)...))
+	}
+}
+
+func (m Money) mustMatch(other Money) {
+	if !m.SameCurrency(other) {
+		panic(&CurrencyMismatchError{Expected: m.currency, Actual: other.currency})
+	}
+}
+
+func (m Money) String() string {
+	return m.Format(EnUS)
+}
@@ -0,0 +1,265 @@
+package money
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestFromFloat(t *testing.T) {
+	tests := []struct {
+		value    float64
+		currency Currency
+		want     int64
+	}{
+		{2.675, CurrencyUSD, 268},
+		{2.665, CurrencyUSD, 267},
+		{-2.675, CurrencyUSD, -268},
+		{0.1 + 0.2, CurrencyUSD, 30},
+		{1234.5, CurrencyJPY, 1235},
+		{0, CurrencyEUR, 0},
+	}
+	for _, tt := range tests {
+		got, err := FromFloat(tt.value, tt.currency)
+		if err != nil {
+			t.Errorf("FromFloat(%v): %v", tt.value, err)
+			continue
+		}
+		if got.MinorUnits() != tt.want {
+			t.Errorf("FromFloat(%v, %s) = %d, want %d", tt.value, tt.currency, got.MinorUnits(), tt.want)
+		}
+	}
+}
+
+func TestFromFloatRejectsInvalidValues(t *testing.T) {
+	tests := []struct {
+		value float64
+		want  error
+	}{
+		{math.NaN(), ErrNotFinite},
+		{math.Inf(1), ErrNotFinite},
+		{math.Inf(-1), ErrNotFinite},
+		{1e20, ErrOverflow},
+		{-1e20, ErrOverflow},
+	}
+	for _, tt := range tests {
+		if _, err := FromFloat(tt.value, CurrencyUSD); !errors.Is(err, tt.want) {
+			t.Errorf("FromFloat(%v) = %v, want %v", tt.value, err, tt.want)
+		}
+	}
+}
+
+func TestUSDPanicsWithClearMessage(t *testing.T) {
+	defer func() {
+		r := recover()
+		message, _ := r.(string)
+		if !strings.Contains(message, "not a finite number") {
+			t.Fatalf("panic = %v, want a message about non-finite amounts", r)
+		}
+	}()
+	USD(math.NaN())
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency Currency
+		want     int64
+		wantErr  error
+	}{
+		{value: "3.49", currency: CurrencyUSD, want: 349},
+		{value: " -0.5 ", currency: CurrencyUSD, want: -50},
+		{value: "900", currency: CurrencyJPY, want: 900},
+		{value: "92233720368547758.07", currency: CurrencyUSD, want: math.MaxInt64},
+		{value: "92233720368547758.08", currency: CurrencyUSD, wantErr: ErrOverflow},
+		{value: "100000000000000000000", currency: CurrencyJPY, wantErr: ErrOverflow},
+	}
+	for _, tt := range tests {
+		got, err := Parse(tt.value, tt.currency)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.value, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got.MinorUnits() != tt.want {
+			t.Errorf("Parse(%q) = %d, %v, want %d", tt.value, got.MinorUnits(), err, tt.want)
+		}
+	}
+
+	for _, invalid := range []string{"", "abc", "1/2", "1e3", "3.499", "1.5"} {
+		currency := CurrencyUSD
+		if invalid == "1.5" {
+			currency = CurrencyJPY
+		}
+		if _, err := Parse(invalid, currency); err == nil {
+			t.Errorf("Parse(%q, %s) should fail", invalid, currency)
+		}
+	}
+}
+
+func TestMulRate(t *testing.T) {
+	tests := []struct {
+		amount int64
+		rate   float64
+		mode   RoundingMode
+		want   int64
+	}{
+		{1000, 0.0825, HalfUp, 83},
+		{1000, 0.0825, HalfEven, 82},
+		{1000, 0.0825, Down, 82},
+		{1000, 0.0835, HalfEven, 84},
+		{-1000, 0.0825, HalfUp, -83},
+		{1000, 0.15, HalfUp, 150},
+	}
+	for _, tt := range tests {
+		got, err := New(tt.amount, CurrencyUSD).MulRate(tt.rate, tt.mode)
+		if err != nil || got.MinorUnits() != tt.want {
+			t.Errorf("%d * %v (mode %d) = %d, %v, want %d", tt.amount, tt.rate, tt.mode, got.MinorUnits(), err, tt.want)
+		}
+	}
+
+	if _, err := USD(10).MulRate(math.NaN(), HalfUp); !errors.Is(err, ErrNotFinite) {
+		t.Errorf("MulRate(NaN) = %v, want ErrNotFinite", err)
+	}
+	if _, err := New(math.MaxInt64, CurrencyUSD).MulRate(2, HalfUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MulRate overflow = %v, want ErrOverflow", err)
+	}
+}
+
+func TestAllocateKeepsEveryCent(t *testing.T) {
+	tests := []struct {
+		amount int64
+		ratios []int
+		want   []int64
+	}{
+		{100, []int{1, 1, 1}, []int64{34, 33, 33}},
+		{-100, []int{1, 1, 1}, []int64{-34, -33, -33}},
+		{5, []int{0, 1, 1}, []int64{0, 3, 2}},
+		{1000, []int{70, 30}, []int64{700, 300}},
+	}
+	for _, tt := range tests {
+		parts, err := New(tt.amount, CurrencyUSD).Allocate(tt.ratios...)
+		if err != nil {
+			t.Fatalf("Allocate: %v", err)
+		}
+		for i, part := range parts {
+			if part.MinorUnits() != tt.want[i] {
+				t.Errorf("Allocate(%d, %v) = %v, want %v", tt.amount, tt.ratios, parts, tt.want)
+				break
+			}
+		}
+	}
+
+	for _, ratios := range [][]int{nil, {0, 0}, {1, -1}} {
+		if _, err := USD(1).Allocate(ratios...); !errors.Is(err, ErrInvalidRatios) {
+			t.Errorf("Allocate(%v) = %v, want ErrInvalidRatios", ratios, err)
+		}
+	}
+	if _, err := USD(1).Split(0); err == nil {
+		t.Error("Split(0) should fail")
+	}
+}
+
+func TestSumAndCurrencyMismatch(t *testing.T) {
+	total, err := Sum(CurrencyUSD, USD(1.25), USD(2.5))
+	if err != nil || total.MinorUnits() != 375 {
+		t.Fatalf("Sum = %v, %v", total, err)
+	}
+	var mismatch *CurrencyMismatchError
+	if _, err := Sum(CurrencyUSD, USD(1), EUR(1)); !errors.As(err, &mismatch) {
+		t.Fatalf("err = %v, want CurrencyMismatchError", err)
+	}
+
+	defer func() {
+		if _, ok := recover().(*CurrencyMismatchError); !ok {
+			t.Fatal("Add with different currencies should panic with CurrencyMismatchError")
+		}
+	}()
+	USD(1).Add(EUR(1))
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		money  Money
+		locale Locale
+		want   string
+	}{
+		{New(123450, CurrencyUSD), EnUS, "$1,234.50"},
+		{New(123450, CurrencyEUR), EsES, "1.234,50 €"},
+		{New(-5, CurrencyUSD), EnUS, "-$0.05"},
+		{New(1234567, CurrencyJPY), FrFR, "1\u202f234\u202f567 ¥"},
+	}
+	for _, tt := range tests {
+		if got := tt.money.Format(tt.locale); got != tt.want {
+			t.Errorf("Format(%s) = %q, want %q", tt.locale.Name, got, tt.want)
+		}
+	}
+	if got := New(-123450, CurrencyUSD).Decimal(); got != "-1234.50" {
+		t.Errorf("Decimal = %q", got)
+	}
+}
+
+func TestArithmeticAtInt64Boundaries(t *testing.T) {
+	maxUSD := New(math.MaxInt64, CurrencyUSD)
+	minUSD := New(math.MinInt64, CurrencyUSD)
+	cent := New(1, CurrencyUSD)
+
+	ok := []struct {
+		name string
+		got  Money
+		want int64
+	}{
+		{"max - 1", maxUSD.Sub(cent), math.MaxInt64 - 1},
+		{"min + 1", minUSD.Add(cent), math.MinInt64 + 1},
+		{"-1 - min", New(-1, CurrencyUSD).Sub(minUSD), math.MaxInt64},
+		{"-max", maxUSD.Negate(), -math.MaxInt64},
+		{"max * -1", maxUSD.Mul(-1), -math.MaxInt64},
+		{"min * 1", minUSD.Mul(1), math.MinInt64},
+	}
+	for _, tt := range ok {
+		if tt.got.MinorUnits() != tt.want {
+			t.Errorf("%s = %d, want %d", tt.name, tt.got.MinorUnits(), tt.want)
+		}
+	}
+
+	overflows := map[string]func(){
+		"max + 1":   func() { maxUSD.Add(cent) },
+		"min - 1":   func() { minUSD.Sub(cent) },
+		"0 - min":   func() { Zero(CurrencyUSD).Sub(minUSD) },
+		"-min":      func() { minUSD.Negate() },
+		"min * -1":  func() { minUSD.Mul(-1) },
+		"max * 2":   func() { maxUSD.Mul(2) },
+		"-1 * min":  func() { New(-1, CurrencyUSD).Mul(math.MinInt64) },
+		"half * -3": func() { New(math.MaxInt64/2, CurrencyUSD).Mul(-3) },
+	}
+	for name, op := range overflows {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				err, _ := recover().(error)
+				if !errors.Is(err, ErrOverflow) {
+					t.Fatalf("recovered %v, want an ErrOverflow panic", err)
+				}
+			}()
+			op()
+		})
+	}
+
+	if _, err := Sum(CurrencyUSD, maxUSD, cent); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Sum err = %v, want ErrOverflow", err)
+	}
+	parts, err := maxUSD.Allocate(1, 1)
+	if err != nil || parts[0].MinorUnits() != math.MaxInt64/2+1 || parts[1].MinorUnits() != math.MaxInt64/2 {
+		t.Errorf("Allocate(max) = %v, %v", parts, err)
+	}
+}
+
+func TestFormatInt64Boundaries(t *testing.T) {
+	if got, want := New(math.MinInt64, CurrencyUSD).Decimal(), "-92233720368547758.08"; got != want {
+		t.Errorf("Decimal(min) = %q, want %q", got, want)
+	}
+	if got, want := New(math.MaxInt64, CurrencyUSD).Format(EnUS), "$92,233,720,368,547,758.07"; got != want {
+		t.Errorf("Format(max) = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,50 @@
+package money
+
+import "math/big"
+
+type RoundingMode int
+
+const (
+	// HalfUp redondea los empates alejándose de cero (2.345 -> 2.35).
+	HalfUp RoundingMode = iota
+	// HalfEven redondea los empates al par más cercano (2.345 -> 2.34).
+	HalfEven
+	// Down trunca hacia cero.
+	Down
+)
+
+// round lleva un racional exacto al entero más cercano según el modo.
+// Falla si el resultado no entra en un int64.
+func round(value *big.Rat, mode RoundingMode) (int64, error) {
+	quotient, remainder := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if remainder.Sign() == 0 || mode == Down {
+		return toInt64(quotient)
+	}
+
+	// Compara 2*|resto| con el denominador para saber si pasamos la mitad.
+	twice := new(big.Int).Abs(remainder)
+	twice.Lsh(twice, 1)
+	away := false
+	switch twice.Cmp(value.Denom()) {
+	case 1:
+		away = true
+	case 0:
+		away = mode == HalfUp || quotient.Bit(0) == 1
+	}
+
+	if away {
+		if value.Sign() < 0 {
+			quotient.Sub(quotient, big.NewInt(1))
+		} else {
+			quotient.Add(quotient, big.NewInt(1))
+		}
+	}
+	return toInt64(quotient)
+}
+
+func toInt64(value *big.Int) (int64, error) {
+	if !value.IsInt64() {
+		return 0, ErrOverflow
+	}
+	return value.Int64(), nil
+}
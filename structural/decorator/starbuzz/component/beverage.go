@@ -1,6 +1,8 @@
 package component
 
+import "designpatterns/internal/money"
+
 type Beverage interface {
-	Cost() float64
+	Cost() money.Money
 	GetDescription() string
 }
@@ -1,5 +1,7 @@
 package concretecomponent
 
+import "designpatterns/internal/money"
+
 type DarkRoast struct {
 	Description string
 }
@@ -10,8 +12,8 @@ func NewDarkRoast() *DarkRoast {
 	}
 }
 
-func (e *DarkRoast) Cost() float64 {
-	return .99
+func (e *DarkRoast) Cost() money.Money {
+	return money.USD(0.99)
 }
 
 func (d *DarkRoast) GetDescription() string {
@@ -1,5 +1,7 @@
 package concretecomponent
 
+import "designpatterns/internal/money"
+
 type Decaf struct {
 	Description string
 }
@@ -10,8 +12,8 @@ func NewDecaf() *Decaf {
 	}
 }
 
-func (e *Decaf) Cost() float64 {
-	return 1.05
+func (e *Decaf) Cost() money.Money {
+	return money.USD(1.05)
 }
 
 func (d *Decaf) GetDescription() string {
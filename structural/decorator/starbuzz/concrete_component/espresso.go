@@ -1,5 +1,7 @@
 package concretecomponent
 
+import "designpatterns/internal/money"
+
 type Espresso struct {
 	Description string
 }
@@ -10,8 +12,8 @@ func NewEspresso() *Espresso {
 	}
 }
 
-func (e *Espresso) Cost() float64 {
-	return 1.99
+func (e *Espresso) Cost() money.Money {
+	return money.USD(1.99)
 }
 
 func (e *Espresso) GetDescription() string {
@@ -1,5 +1,7 @@
 package concretecomponent
 
+import "designpatterns/internal/money"
+
 type HouseBlend struct {
 	Description string
 }
@@ -10,8 +12,8 @@ func NewHouseBlend() *HouseBlend {
 	}
 }
 
-func (h *HouseBlend) Cost() float64 {
-	return .89
+func (h *HouseBlend) Cost() money.Money {
+	return money.USD(0.89)
 }
 
 func (h *HouseBlend) GetDescription() string {
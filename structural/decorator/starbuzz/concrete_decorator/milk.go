@@ -1,6 +1,9 @@
 package concretedecorator
 
-import "designpatterns/structural/decorator/starbuzz/component"
+import (
+	"designpatterns/internal/money"
+	"designpatterns/structural/decorator/starbuzz/component"
+)
 
 type Milk struct {
 	Beverage component.Beverage
@@ -15,8 +18,8 @@ func NewMilk(b component.Beverage) *Milk {
 	}
 }
 
-func (m *Milk) Cost() float64 {
-	return m.Beverage.Cost() + .10
+func (m *Milk) Cost() money.Money {
+	return m.Beverage.Cost().Add(money.USD(0.10))
 }
 
 func (m *Milk) GetDescription() string {
@@ -1,6 +1,9 @@
 package concretedecorator
 
-import "designpatterns/structural/decorator/starbuzz/component"
+import (
+	"designpatterns/internal/money"
+	"designpatterns/structural/decorator/starbuzz/component"
+)
 
 type Mocha struct {
 	Beverage component.Beverage
@@ -15,8 +18,8 @@ func NewMocha(b component.Beverage) *Mocha {
 	}
 }
 
-func (m *Mocha) Cost() float64 {
-	return m.Beverage.Cost() + .20
+func (m *Mocha) Cost() money.Money {
+	return m.Beverage.Cost().Add(money.USD(0.20))
 }
 
 func (m *Mocha) GetDescription() string {
@@ -1,6 +1,9 @@
 package concretedecorator
 
-import "designpatterns/structural/decorator/starbuzz/component"
+import (
+	"designpatterns/internal/money"
+	"designpatterns/structural/decorator/starbuzz/component"
+)
 
 type Soy struct {
 	Beverage component.Beverage
@@ -15,8 +18,8 @@ func NewSoy(b component.Beverage) *Soy {
 	}
 }
 
-func (s *Soy) Cost() float64 {
-	return s.Beverage.Cost() + .15
+func (s *Soy) Cost() money.Money {
+	return s.Beverage.Cost().Add(money.USD(0.15))
 }
 
 func (s *Soy) GetDescription() string {
@@ -1,6 +1,9 @@
 package concretedecorator
 
-import "designpatterns/structural/decorator/starbuzz/component"
+import (
+	"designpatterns/internal/money"
+	"designpatterns/structural/decorator/starbuzz/component"
+)
 
 type Whip struct {
 	Beverage component.Beverage
@@ -15,8 +18,8 @@ func NewWhip(b component.Beverage) *Whip {
 	}
 }
 
-func (w *Whip) Cost() float64 {
-	return w.Beverage.Cost() + .10
+func (w *Whip) Cost() money.Money {
+	return w.Beverage.Cost().Add(money.USD(0.10))
 }
 
 func (w *Whip) GetDescription() string {
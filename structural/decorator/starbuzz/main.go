@@ -11,16 +11,16 @@ func main() {
 
 	// Crear un espresso base
 	espresso := concretecomponent.NewEspresso()
-	fmt.Printf("%s: $%.2f\n", espresso.GetDescription(), espresso.Cost())
+	fmt.Printf("%s: %s\n", espresso.GetDescription(), espresso.Cost())
 
 	// Agregar leche
 	espressoWithMilk := concretedecorator.NewMilk(espresso)
-	fmt.Printf("%s: $%.2f\n", espressoWithMilk.GetDescription(), espressoWithMilk.Cost())
+	fmt.Printf("%s: %s\n", espressoWithMilk.GetDescription(), espressoWithMilk.Cost())
 
 	// Agregar mocha y whip
 	espressoWithMilkMochaWhip := concretedecorator.NewWhip(
 		concretedecorator.NewMocha(espressoWithMilk))
-	fmt.Printf("%s: $%.2f\n", espressoWithMilkMochaWhip.GetDescription(), espressoWithMilkMochaWhip.Cost())
+	fmt.Printf("%s: %s\n", espressoWithMilkMochaWhip.GetDescription(), espressoWithMilkMochaWhip.Cost())
 
 	fmt.Println("\n--- Otra bebida ---")
 
@@ -31,5 +31,5 @@ func main() {
 			concretedecorator.NewSoy(
 				concretedecorator.NewMilk(houseBlend))))
 
-	fmt.Printf("%s: $%.2f\n", complexBeverage.GetDescription(), complexBeverage.Cost())
+	fmt.Printf("%s: %s\n", complexBeverage.GetDescription(), complexBeverage.Cost())
 }